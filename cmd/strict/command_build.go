@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/spf13/cobra"
 	"github.com/strict-lang/sdk/pkg/buildtool"
 	"github.com/strict-lang/sdk/pkg/compiler/backend"
@@ -22,10 +23,14 @@ var buildCommand = &cobra.Command{
 }
 
 var buildOptions struct {
-	outputPath   string
-	reportFormat string
-	backendName  string
-	debug        bool
+	outputPath       string
+	reportFormat     string
+	backendName      string
+	profile          string
+	locale           string
+	debug            bool
+	fix              bool
+	warningsAsErrors bool
 }
 
 func init() {
@@ -33,8 +38,13 @@ func init() {
 	flags.StringVarP(&buildOptions.backendName, "backend", "b", "c++", "backend used in code generation")
 	flags.StringVarP(&buildOptions.outputPath, "destination", "d", "build/silk", "build destination")
 	flags.BoolVarP(&buildOptions.debug, "debug", "z", false, "enable debug mode")
+	flags.StringVarP(&buildOptions.profile, "profile", "p", "", "build profile declared in the build config")
 	flags.StringVarP(&buildOptions.reportFormat, "report-format", "r", "text",
-		"format in which the report is encoded (json/pretty-json/xml/pretty-xml/text)")
+		"format in which the report is encoded (json/pretty-json/xml/pretty-xml/sarif/gnu/text)")
+	flags.BoolVar(&buildOptions.fix, "fix", false, "apply the machine-applicable fixes of the diagnostics")
+	flags.BoolVar(&buildOptions.warningsAsErrors, "warnings-as-errors", false, "report every warning as an error")
+	flags.StringVar(&buildOptions.locale, "locale", "",
+		"locale of the diagnostic messages or path to a translation file")
 }
 
 func disableLogging() {
@@ -56,10 +66,19 @@ var reportFormats = map[string]func(report.Report, *linemap.Table) report.Output
 	"pretty-xml": func(input report.Report, lineMaps *linemap.Table) report.Output {
 		return report.NewSerializingOutput(report.NewPrettyXmlSerializationFormat(), input)
 	},
+	"sarif": func(input report.Report, lineMaps *linemap.Table) report.Output {
+		return report.NewSarifOutput(input, Version)
+	},
+	"gnu": func(input report.Report, lineMaps *linemap.Table) report.Output {
+		return report.NewGnuOutput(input)
+	},
 }
 
 func RunCompile(command *cobra.Command, arguments []string) error {
 	fixOptions()
+	if err := selectLocale(buildOptions.locale, command.ErrOrStderr()); err != nil {
+		return err
+	}
 	if !buildOptions.debug {
 		disableLogging()
 	}
@@ -68,7 +87,25 @@ func RunCompile(command *cobra.Command, arguments []string) error {
 		return err
 	}
 	output := createOutput(compilationReport, lineMaps)
-	return output.Print(command.OutOrStdout())
+	if err := output.Print(command.OutOrStdout()); err != nil {
+		return err
+	}
+	if buildOptions.fix {
+		return applyFixes(command, compilationReport)
+	}
+	return nil
+}
+
+// applyFixes applies the fixes of the reports diagnostics. The number of
+// applied fixes is written to the error stream, so that it does not corrupt
+// serialized reports.
+func applyFixes(command *cobra.Command, compilationReport report.Report) error {
+	applied, err := report.ApplyFixes(compilationReport)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(command.ErrOrStderr(), "applied %d fixes\n", applied)
+	return err
 }
 
 func fixOptions() {
@@ -93,6 +130,9 @@ func runCompilation() (report.Report, *linemap.Table, error) {
 		RootPath:      directory,
 		Configuration: config,
 		Backend: selectBackend(),
+		BackendName: selectBackendName(),
+		Profile: buildOptions.profile,
+		WarningsAsErrors: buildOptions.warningsAsErrors,
 	}
   return build.Run()
 }
@@ -105,6 +145,16 @@ func selectBackend() backend.Backend {
 	return cpp.NewBackend()
 }
 
+// selectBackendName returns the name of the backend that is selected by
+// selectBackend, which falls back to the C++ backend.
+func selectBackendName() string {
+	_, ok := backend.LookupInIsolate(isolate.SingleThreaded(), buildOptions.backendName)
+	if ok {
+		return buildOptions.backendName
+	}
+	return cpp.BackendName
+}
+
 func prettyPrint(value interface{}) string {
 	content, err := json.MarshalIndent(value, "  ", "  ")
 	if err == nil {
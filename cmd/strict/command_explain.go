@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+)
+
+var explainCommand = &cobra.Command{
+	Use:   "explain [code]",
+	Short: "Explains the diagnostic with the given code",
+	Long: `Explain prints a detailed description of the diagnostic with the given
+code, together with examples of code that causes it. Every code is listed,
+if no code is given.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: RunExplain,
+}
+
+func RunExplain(command *cobra.Command, arguments []string) error {
+	if len(arguments) == 0 {
+		listExplanations(command)
+		return nil
+	}
+	explanation, ok := diagnostic.LookupExplanation(diagnostic.Code(arguments[0]))
+	if !ok {
+		return fmt.Errorf("there is no diagnostic with the code %s", arguments[0])
+	}
+	return explanation.Write(command.OutOrStdout())
+}
+
+func listExplanations(command *cobra.Command) {
+	for _, explanation := range diagnostic.ListExplanations() {
+		fmt.Fprintf(command.OutOrStdout(), "%s  %s\n", explanation.Code, explanation.Title)
+	}
+}
@@ -3,12 +3,9 @@ package main
 import (
 	"github.com/spf13/cobra"
 	"github.com/strict-lang/sdk/pkg/compiler"
-	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/backend"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree/pretty"
-	"github.com/strict-lang/sdk/pkg/compiler/isolate"
-	"github.com/strict-lang/sdk/pkg/compiler/lowering"
-	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
 	"os"
 	"strings"
 )
@@ -20,6 +17,15 @@ var treeCommand = &cobra.Command{
 	Run:   runTreeCommand,
 }
 
+var treeOptions struct {
+	backendName string
+}
+
+func init() {
+	flags := treeCommand.Flags()
+	flags.StringVarP(&treeOptions.backendName, "backend", "b", "c++", "backend that the tree is lowered for")
+}
+
 func runTreeCommand(command *cobra.Command, arguments []string) {
 	if sourceFile, ok := findSourceFileInArguments(command, arguments); ok {
 		defer sourceFile.Close()
@@ -41,10 +47,5 @@ func parseAndPrintAst(command *cobra.Command, sourceFile *os.File) {
 }
 
 func analyseAndLowerUnit(unit *tree.TranslationUnit) {
-	pass, _ := passes.NewExecution(lowering.LetBindingLoweringPassId, &passes.Context{
-		Unit:       unit,
-		Diagnostic: diagnostic.NewBag(),
-		Isolate:    isolate.SingleThreaded(),
-	})
-	_ = pass.Run()
+	compiler.AnalyseAndLower(unit, treeOptions.backendName, backend.Options{})
 }
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+)
+
+// strictLocaleVariable selects the locale of the diagnostic messages. It is
+// only read by Strict and thus treated like a passed locale.
+const strictLocaleVariable = "STRICT_LOCALE"
+
+// defaultLocales are the values of the shared variables, that select the
+// default locale of the system. They are no locales of their own and do not
+// need any messages.
+var defaultLocales = map[string]bool{"C": true, "POSIX": true}
+
+// localeVariables are the environment variables, that select the locale of
+// the diagnostic messages if no locale is passed. They are checked in order.
+var localeVariables = []string{strictLocaleVariable, "LC_ALL", "LC_MESSAGES", "LANG"}
+
+// selectLocale selects the locale of the diagnostic messages. The locale is
+// either the name of a locale or the path to a translation file. Passed
+// locales and the locale of STRICT_LOCALE have to exist, while the locales of
+// the other variables fall back to the default locale, since they are shared
+// with other programs. Falling back is reported to the writer as a warning.
+func selectLocale(locale string, warnings io.Writer) error {
+	if locale != "" {
+		return loadAndSelectLocale(locale)
+	}
+	for _, variable := range localeVariables {
+		value := os.Getenv(variable)
+		if value == "" {
+			continue
+		}
+		if variable == strictLocaleVariable {
+			return loadAndSelectLocale(value)
+		}
+		if err := loadAndSelectLocale(value); err != nil && !isDefaultLocale(value) {
+			_, _ = fmt.Fprintf(warnings,
+				"warning: the locale %s of %s can not be selected, falling back to %s: %v\n",
+				value, variable, diagnostic.DefaultLocale, err)
+		}
+		return nil
+	}
+	return nil
+}
+
+func isDefaultLocale(locale string) bool {
+	if index := strings.IndexAny(locale, ".@"); index >= 0 {
+		locale = locale[:index]
+	}
+	return defaultLocales[locale]
+}
+
+func loadAndSelectLocale(locale string) error {
+	if isExistingFile(locale) {
+		loaded, err := diagnostic.LoadCatalog(locale)
+		if err != nil {
+			return err
+		}
+		locale = loaded
+	}
+	return diagnostic.SelectLocale(locale)
+}
+
+func isExistingFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
@@ -24,4 +24,5 @@ func init() {
 	baseCommand.AddCommand(tokenizeCommand)
 	baseCommand.AddCommand(initCommand)
 	baseCommand.AddCommand(runCommand)
+	baseCommand.AddCommand(explainCommand)
 }
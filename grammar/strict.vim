@@ -33,10 +33,10 @@ if exists("strict_highlight_all")
 endif
 
 syn keyword strictStatement	false true empty
-syn keyword strictStatement	assert break continue
+syn keyword strictStatement	assert requires ensures break continue
 syn keyword strictStatement	lambda test  return  yield
 syn keyword strictStatement	method nextgroup=strictFunction skipwhite
-syn keyword strictConditional	else if do
+syn keyword strictConditional	else if do match
 syn keyword strictRepeat for
 syn keyword strictOperator	and or is isnt
 syn keyword strictException	throw try catch
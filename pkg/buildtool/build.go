@@ -2,6 +2,7 @@ package buildtool
 
 import (
 	"github.com/strict-lang/sdk/pkg/buildtool/namespace"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis"
 	"github.com/strict-lang/sdk/pkg/compiler/backend"
 	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
 	"github.com/strict-lang/sdk/pkg/compiler/input/linemap"
@@ -14,6 +15,14 @@ type Build struct {
 	RootPath      string
 	Configuration Configuration
 	Backend backend.Backend
+	// BackendName is the name of the backend. It selects how the units are
+	// lowered before the backend generates their code.
+	BackendName string
+	// Profile is the name of the profile that is used. It is looked up in
+	// the configuration, the default profile is used if it is empty.
+	Profile string
+	// WarningsAsErrors raises every reported warning to an error.
+	WarningsAsErrors bool
 }
 
 type result struct {
@@ -47,6 +56,13 @@ func containsError(diagnostics *diagnostic.Diagnostics) bool {
 }
 
 func (build *Build) run() result {
+	profile, err := build.Configuration.FindProfile(build.Profile)
+	if err != nil {
+		return result{
+			error:       err,
+			diagnostics: diagnostic.Empty(),
+		}
+	}
 	namespaces, err := build.scanNamespaces()
 	if err != nil {
 		return result{
@@ -54,13 +70,35 @@ func (build *Build) run() result {
 			diagnostics: diagnostic.Empty(),
 		}
 	}
-	packageResult := compilePackage(build.Backend, namespaces)
+	packageResult := compilePackage(
+		build.Backend,
+		build.BackendName,
+		profile.createBackendOptions(),
+		namespaces,
+		build.createSearchPath())
 	return result{
-		diagnostics: packageResult.diagnostics,
+		diagnostics: build.createDiagnosticPolicy().apply(packageResult.diagnostics),
 		lineMaps: packageResult.lineMaps,
 	}
 }
 
+func (build *Build) createDiagnosticPolicy() *diagnosticPolicy {
+	return newDiagnosticPolicy(build.Configuration.Diagnostics, build.WarningsAsErrors)
+}
+
+// createSearchPath creates the search path of imported namespaces from the
+// import paths of the configuration.
+func (build *Build) createSearchPath() *analysis.SearchPath {
+	var directories []string
+	for _, path := range build.Configuration.ImportPaths {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(build.RootPath, path)
+		}
+		directories = append(directories, path)
+	}
+	return analysis.NewSearchPath(directories...)
+}
+
 func (build *Build) scanNamespaces() (*namespace.Table, error) {
 	sourceDirectoryPath := filepath.Join(build.RootPath, sourceDirectoryName)
 	rootPackageName := build.Configuration.PackageName
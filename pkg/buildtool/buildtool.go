@@ -22,6 +22,7 @@ func translateDiagnosticEntry(entry diagnostic.Entry) report.Diagnostic {
 	return report.Diagnostic{
 		Kind:    translateDiagnosticKind(entry.Kind),
 		Message: entry.Message,
+		Code:    string(entry.Code),
 		TextRange: report.TextRange{
 			Text: entry.Source,
 			Range: report.PositionRange{
@@ -30,9 +31,45 @@ func translateDiagnosticEntry(entry diagnostic.Entry) report.Diagnostic {
 			},
 			File: entry.UnitName,
 		},
+		Fixes:  translateFixes(entry),
+		Labels: translateLabels(entry),
 	}
 }
 
+func translateLabels(entry diagnostic.Entry) (labels []report.Label) {
+	for _, label := range entry.Labels {
+		labels = append(labels, report.Label{
+			Message: label.Message,
+			Range: report.PositionRange{
+				BeginPosition: translatePosition(label.Position.Begin),
+				EndPosition:   translatePosition(label.Position.End),
+			},
+		})
+	}
+	return labels
+}
+
+func translateFixes(entry diagnostic.Entry) (fixes []report.Fix) {
+	for _, fix := range entry.Fixes {
+		translated := report.Fix{
+			Title:             fix.Title,
+			MachineApplicable: fix.MachineApplicable,
+		}
+		for _, edit := range fix.Edits {
+			translated.Edits = append(translated.Edits, report.Edit{
+				File: entry.UnitName,
+				Range: report.PositionRange{
+					BeginPosition: translatePosition(edit.Position.Begin),
+					EndPosition:   translatePosition(edit.Position.End),
+				},
+				Replacement: edit.Replacement,
+			})
+		}
+		fixes = append(fixes, translated)
+	}
+	return fixes
+}
+
 func translatePosition(position input.Position) report.Position {
 	return report.Position{
 		Line:   int(position.Line.Index),
@@ -1,7 +1,10 @@
 package buildtool
 
 import (
+	"errors"
 	"fmt"
+	"github.com/strict-lang/sdk/pkg/compiler/backend"
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
 )
@@ -11,6 +14,43 @@ type Configuration struct {
 	Author       string                    `yaml:"author" json:"author"`
 	Description  string                    `yaml:"description" json:"description"`
 	Repositories []RepositoryConfiguration `yaml:"repositories" json:"repositories"`
+	Profiles     map[string]Profile        `yaml:"profiles" json:"profiles"`
+	// ImportPaths are the directories, that are searched for the descriptors
+	// of imported namespaces. Relative paths are resolved against the root
+	// of the package.
+	ImportPaths []string `yaml:"importPaths" json:"importPaths"`
+	// Diagnostics maps the codes of diagnostics to the severity, with which
+	// they are reported. Diagnostics without a configured severity are
+	// reported with their own kind.
+	Diagnostics map[diagnostic.Code]Severity `yaml:"diagnostics" json:"diagnostics"`
+}
+
+// Profile configures how a package is built. Profiles are declared in the
+// build file and selected when running the build.
+type Profile struct {
+	DisableContracts bool `yaml:"disableContracts" json:"disableContracts"`
+	LazySequences    bool `yaml:"lazySequences" json:"lazySequences"`
+}
+
+// FindProfile returns the profile with the given name. An empty name selects
+// the default profile. Names of profiles, that are not declared by the
+// configuration, are rejected, so that misspelled profiles are not replaced
+// by the default profile silently.
+func (configuration Configuration) FindProfile(name string) (Profile, error) {
+	if name == "" {
+		return Profile{}, nil
+	}
+	if profile, ok := configuration.Profiles[name]; ok {
+		return profile, nil
+	}
+	return Profile{}, fmt.Errorf("unknown profile %s", name)
+}
+
+func (profile Profile) createBackendOptions() backend.Options {
+	return backend.Options{
+		DisableContracts: profile.DisableContracts,
+		LazySequences:    profile.LazySequences,
+	}
 }
 
 type RepositoryConfiguration struct {
@@ -27,5 +67,17 @@ func ReadConfiguration(file string) (Configuration, error) {
 	if err := yaml.Unmarshal(content, &configuration); err != nil {
 		return Configuration{}, fmt.Errorf("could not parse build config: %v", err)
 	}
+	if err := configuration.validate(); err != nil {
+		return Configuration{}, fmt.Errorf("invalid build config: %v", err)
+	}
 	return configuration, nil
 }
+
+func (configuration Configuration) validate() error {
+	for code, severity := range configuration.Diagnostics {
+		if !severity.isValid() {
+			return errors.New(diagnostic.FormatMessage(CodeUnknownSeverity, severity, code))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package buildtool
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+)
+
+func TestConfiguration_FindProfile(testing *testing.T) {
+	configuration := Configuration{
+		Profiles: map[string]Profile{
+			"release": {DisableContracts: true},
+		},
+	}
+	if profile, err := configuration.FindProfile("release"); err != nil || !profile.DisableContracts {
+		testing.Errorf("expected the release profile to be found, got %+v, %v", profile, err)
+	}
+	if profile, err := configuration.FindProfile(""); err != nil || profile.DisableContracts {
+		testing.Errorf("expected the default profile to be found, got %+v, %v", profile, err)
+	}
+	if _, err := configuration.FindProfile("relaese"); err == nil {
+		testing.Error("expected an unknown profile to be rejected")
+	}
+}
+
+func TestBuild_FailsOnUnknownProfile(testing *testing.T) {
+	build := Build{Profile: "relaese"}
+	_, _, err := build.Run()
+	if err == nil || !strings.Contains(err.Error(), "unknown profile relaese") {
+		testing.Errorf("expected the build to fail with an unknown profile, got %v", err)
+	}
+}
+
+func TestConfiguration_RejectsUnknownSeverities(testing *testing.T) {
+	configuration := Configuration{
+		Diagnostics: map[diagnostic.Code]Severity{"S0801": "ignore"},
+	}
+	err := configuration.validate()
+	if err == nil || err.Error() != "unknown severity ignore of diagnostic S0801" {
+		testing.Errorf("expected the unknown severity to be rejected, got %v", err)
+	}
+}
@@ -22,30 +22,41 @@ func (chain *DependChain) Target() *Dependency {
 	return chain.dependencies[lastIndex]
 }
 
+// At returns the element of the chain at the index, beginning with the root.
+func (chain *DependChain) At(index int) *Dependency {
+	return chain.dependencies[index]
+}
+
 func (chain *DependChain) Write(writer io.Writer) error {
 	return writeDependencyChainElements(chain.dependencies, writer)
 }
 
 func (chain *DependChain) WriteLimited(writer io.Writer, limit int) error {
+	return writeDependencyChainElements(chain.ListLimited(limit), writer)
+}
+
+// ListLimited lists at most limit elements of the chain. Longer chains are
+// shortened in the middle, so that their root and target are kept.
+func (chain *DependChain) ListLimited(limit int) []*Dependency {
 	if chain.Length() <= limit {
-		return writeDependencyChainElements(chain.dependencies, writer)
+		return chain.dependencies
 	}
-	limited := chain.listRelevantElements(limit)
-	return writeDependencyChainElements(limited, writer)
+	return chain.listRelevantElements(limit)
 }
 
+// listRelevantElements lists the first and last elements of the chain. The
+// elements are copied, so that the chain itself is not modified.
 func (chain *DependChain) listRelevantElements(limit int) []*Dependency {
 	lastHeadElement := limit / 2
-	lastIndex := chain.Length() - 1
-	lastTailElement := lastIndex - limit/2
-	headElements := chain.dependencies[0:lastHeadElement]
-	tailElements := chain.dependencies[lastTailElement:lastIndex]
-	return append(headElements, tailElements...)
+	firstTailElement := chain.Length() - (limit - lastHeadElement)
+	relevant := make([]*Dependency, 0, limit)
+	relevant = append(relevant, chain.dependencies[0:lastHeadElement]...)
+	return append(relevant, chain.dependencies[firstTailElement:]...)
 }
 
 func writeDependencyChainElements(elements []*Dependency, writer io.Writer) error {
 	lastIndex := len(elements) - 1
-	for _, element := range elements {
+	for _, element := range elements[:lastIndex] {
 		if err := writeDependencyChainElement(element, writer); err != nil {
 			return err
 		}
@@ -22,7 +22,27 @@ func (version *Version) String() string {
 	return fmt.Sprintf("%d:%d:%d", version.major, version.minor, version.patch)
 }
 
+// NewLocal creates a dependency on a namespace of the compiled package. Local
+// dependencies have neither a group nor a version.
+func NewLocal(name string) *Dependency {
+	return &Dependency{name: name}
+}
+
+// AddDependency adds a dependency, that the dependency itself depends on.
+func (dependency *Dependency) AddDependency(target *Dependency) {
+	dependency.dependencies = append(dependency.dependencies, target)
+}
+
+func (dependency *Dependency) Name() string {
+	return dependency.name
+}
+
+// Id returns the unique identifier of the dependency. Local dependencies are
+// identified by their name.
 func (dependency *Dependency) Id() string {
+	if dependency.isLocal() {
+		return dependency.name
+	}
 	return fmt.Sprintf(
 		"%s:%s:%s",
 		dependency.group,
@@ -30,6 +50,10 @@ func (dependency *Dependency) Id() string {
 		dependency.version)
 }
 
+func (dependency *Dependency) isLocal() bool {
+	return dependency.group == "" && dependency.version == ""
+}
+
 type Module struct{}
 
 const dependChainRootIndex = 0
@@ -38,15 +62,146 @@ const dependChainRootIndex = 0
 // itself depends on the root, thus creating a circle.
 func detectCirculars(root *Dependency) (chains []DependChain, found bool) {
 	for _, dependency := range root.dependencies {
-		chain, foundInPath := detectCircularsInPath(root, dependency)
-		chains = append(chains, chain)
-		if foundInPath {
+		if chain, foundInPath := detectCircularsInPath(root, dependency); foundInPath {
+			chains = append(chains, chain)
 			found = true
 		}
 	}
 	return chains, found
 }
 
+// detectCircularsInPath searches a path from the target back to the root. The
+// returned chain begins and ends with the root.
 func detectCircularsInPath(root *Dependency, target *Dependency) (DependChain, bool) {
-	return DependChain{}, false
+	visited := map[*Dependency]bool{}
+	path, found := searchPath(target, root, visited)
+	if !found {
+		return DependChain{}, false
+	}
+	return DependChain{dependencies: append([]*Dependency{root}, path...)}, true
+}
+
+func searchPath(
+	begin *Dependency, end *Dependency, visited map[*Dependency]bool) ([]*Dependency, bool) {
+
+	if begin == end {
+		return []*Dependency{end}, true
+	}
+	if visited[begin] {
+		return nil, false
+	}
+	visited[begin] = true
+	for _, dependency := range begin.dependencies {
+		if path, found := searchPath(dependency, end, visited); found {
+			return append([]*Dependency{begin}, path...), true
+		}
+	}
+	return nil, false
+}
+
+// DetectCycles detects every cycle in the graph of the passed dependencies.
+// Each cycle is reported once, beginning and ending with the dependency at
+// which it has been entered first. Dependencies are searched in order, thus
+// the result is deterministic if the order of the passed dependencies is.
+func DetectCycles(dependencies []*Dependency) []DependChain {
+	detection := &cycleDetection{states: map[*Dependency]searchState{}}
+	for _, dependency := range dependencies {
+		detection.search(dependency)
+	}
+	return detection.cycles
+}
+
+type searchState int
+
+const (
+	unvisited searchState = iota
+	inProgress
+	completed
+)
+
+type cycleDetection struct {
+	states map[*Dependency]searchState
+	path   []*Dependency
+	cycles []DependChain
+}
+
+func (detection *cycleDetection) search(dependency *Dependency) {
+	switch detection.states[dependency] {
+	case inProgress:
+		detection.recordCycle(dependency)
+		return
+	case completed:
+		return
+	}
+	detection.states[dependency] = inProgress
+	detection.path = append(detection.path, dependency)
+	for _, child := range dependency.dependencies {
+		detection.search(child)
+	}
+	detection.path = detection.path[:len(detection.path)-1]
+	detection.states[dependency] = completed
+}
+
+// recordCycle records the part of the current path, that begins with the
+// dependency which is visited again.
+func (detection *cycleDetection) recordCycle(dependency *Dependency) {
+	for index, element := range detection.path {
+		if element == dependency {
+			cycle := make([]*Dependency, 0, len(detection.path)-index+1)
+			cycle = append(cycle, detection.path[index:]...)
+			cycle = append(cycle, dependency)
+			detection.cycles = append(detection.cycles, DependChain{dependencies: cycle})
+			return
+		}
+	}
+}
+
+// SortTopologically sorts the dependencies, so that every dependency follows
+// the dependencies which it depends on. Dependencies, that are part of a cycle
+// or depend on one, can not be sorted and are returned separately. Both lists
+// keep the order of the passed dependencies where possible, thus the result
+// is deterministic if the order of the passed dependencies is.
+func SortTopologically(dependencies []*Dependency) (sorted []*Dependency, unsortable []*Dependency) {
+	sorting := &topologicalSorting{
+		states:   map[*Dependency]searchState{},
+		sortable: map[*Dependency]bool{},
+	}
+	for _, dependency := range dependencies {
+		sorting.search(dependency)
+	}
+	return sorting.sorted, sorting.unsortable
+}
+
+type topologicalSorting struct {
+	states     map[*Dependency]searchState
+	sortable   map[*Dependency]bool
+	sorted     []*Dependency
+	unsortable []*Dependency
+}
+
+// search visits the dependencies of the dependency before the dependency
+// itself is added. It returns false, if the dependency is part of a cycle or
+// depends on one.
+func (sorting *topologicalSorting) search(dependency *Dependency) bool {
+	switch sorting.states[dependency] {
+	case inProgress:
+		return false
+	case completed:
+		return sorting.sortable[dependency]
+	}
+	sorting.states[dependency] = inProgress
+	sortable := true
+	for _, child := range dependency.dependencies {
+		if !sorting.search(child) {
+			sortable = false
+		}
+	}
+	sorting.states[dependency] = completed
+	sorting.sortable[dependency] = sortable
+	if sortable {
+		sorting.sorted = append(sorting.sorted, dependency)
+	} else {
+		sorting.unsortable = append(sorting.unsortable, dependency)
+	}
+	return sortable
 }
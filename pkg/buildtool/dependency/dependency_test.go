@@ -0,0 +1,100 @@
+package dependency
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectCycles(testing *testing.T) {
+	first, second, third := NewLocal("first"), NewLocal("second"), NewLocal("third")
+	first.AddDependency(second)
+	second.AddDependency(third)
+	third.AddDependency(first)
+	unrelated := NewLocal("unrelated")
+	unrelated.AddDependency(first)
+
+	cycles := DetectCycles([]*Dependency{unrelated, first, second, third})
+	if len(cycles) != 1 {
+		testing.Fatalf("expected one cycle but got %d", len(cycles))
+	}
+	var output strings.Builder
+	if err := cycles[0].Write(&output); err != nil {
+		testing.Fatal(err)
+	}
+	expected := "first\nreferences second\nreferences third\nreferences first"
+	if output.String() != expected {
+		testing.Errorf("unexpected chain %q, expected %q", output.String(), expected)
+	}
+}
+
+func TestDetectCycles_IgnoresAcyclicGraphs(testing *testing.T) {
+	first, second, third := NewLocal("first"), NewLocal("second"), NewLocal("third")
+	first.AddDependency(second)
+	first.AddDependency(third)
+	second.AddDependency(third)
+
+	if cycles := DetectCycles([]*Dependency{first, second, third}); len(cycles) != 0 {
+		testing.Errorf("expected no cycles but got %d", len(cycles))
+	}
+}
+
+func TestDetectCircularsInPath(testing *testing.T) {
+	root, target := NewLocal("root"), NewLocal("target")
+	root.AddDependency(target)
+	target.AddDependency(root)
+
+	chains, found := detectCirculars(root)
+	if !found || len(chains) != 1 {
+		testing.Fatalf("expected the circle to be detected")
+	}
+	if chains[0].Length() != 3 || chains[0].Root() != root || chains[0].Target() != root {
+		testing.Errorf("unexpected chain of length %d", chains[0].Length())
+	}
+}
+
+func TestDependChain_WriteLimited(testing *testing.T) {
+	var dependencies []*Dependency
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		dependencies = append(dependencies, NewLocal(name))
+	}
+	chain := DependChain{dependencies: dependencies}
+	var output strings.Builder
+	if err := chain.WriteLimited(&output, 3); err != nil {
+		testing.Fatal(err)
+	}
+	expected := "a\nreferences d\nreferences e"
+	if output.String() != expected {
+		testing.Errorf("unexpected chain %q, expected %q", output.String(), expected)
+	}
+	if chain.Length() != 5 || chain.Target().Id() != "e" {
+		testing.Errorf("limited writing modified the chain")
+	}
+}
+
+func TestSortTopologically(testing *testing.T) {
+	first, second, third := NewLocal("first"), NewLocal("second"), NewLocal("third")
+	first.AddDependency(third)
+	third.AddDependency(second)
+	cyclic, other, blocked := NewLocal("cyclic"), NewLocal("other"), NewLocal("blocked")
+	cyclic.AddDependency(other)
+	other.AddDependency(cyclic)
+	blocked.AddDependency(first)
+	blocked.AddDependency(other)
+
+	sorted, unsortable := SortTopologically(
+		[]*Dependency{blocked, cyclic, first, other, second, third})
+	if names := joinNames(sorted); names != "second third first" {
+		testing.Errorf("unexpected order %q", names)
+	}
+	if names := joinNames(unsortable); names != "cyclic other blocked" {
+		testing.Errorf("unexpected unsortable dependencies %q", names)
+	}
+}
+
+func joinNames(dependencies []*Dependency) string {
+	names := make([]string, len(dependencies))
+	for index, dependency := range dependencies {
+		names[index] = dependency.Name()
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,67 @@
+package buildtool
+
+import "github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+
+const (
+	CodeImportCycle      diagnostic.Code = "B0001"
+	CodeBlockedNamespace diagnostic.Code = "B0002"
+	CodeUnknownSeverity  diagnostic.Code = "B0003"
+)
+
+func init() {
+	diagnostic.RegisterExplanations(&diagnostic.Explanation{
+		Code:  CodeImportCycle,
+		Title: "Cyclic import of namespaces",
+		Description: `
+Namespaces are compiled in the order of their imports, every namespace has
+to be compiled before the namespaces, that import it. Namespaces which import
+each other, directly or through other namespaces, can thus not be compiled.
+The reported chain lists the namespaces of the cycle in the order of their
+imports. The cycle is usually broken by moving the shared declarations into
+a namespace of their own.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+// Geometry/Shape.strict
+import Rendering
+
+// Rendering/Canvas.strict
+import Geometry`,
+				Corrected: `
+// Geometry/Shape.strict
+import Color
+
+// Rendering/Canvas.strict
+import Color
+import Geometry`,
+			},
+		},
+	})
+	diagnostic.RegisterExplanations(&diagnostic.Explanation{
+		Code:  CodeBlockedNamespace,
+		Title: "Namespace blocked by an import cycle",
+		Description: `
+The namespace is not part of an import cycle, but imports a namespace that
+is, directly or through other namespaces. Since the namespaces of the cycle
+can not be compiled, the importing namespace is not compiled either. The
+error is resolved by breaking the cycle, which is reported separately.`,
+	})
+	diagnostic.RegisterExplanations(&diagnostic.Explanation{
+		Code:  CodeUnknownSeverity,
+		Title: "Unknown severity of a diagnostic",
+		Description: `
+The diagnostics section of the build config overrides the severity of the
+diagnostics with a code. The severity has to be one of error, warning, info
+or off, the build is not started with any other severity.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+diagnostics:
+  S0801: ignore`,
+				Corrected: `
+diagnostics:
+  S0801: off`,
+			},
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package buildtool
+
+import (
+	"strings"
+
+	"github.com/strict-lang/sdk/pkg/buildtool/dependency"
+	"github.com/strict-lang/sdk/pkg/buildtool/namespace"
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+)
+
+// importCycleChainLimit is the maximum number of namespaces, that are written
+// when an import cycle is reported. Longer cycles are shortened in the middle.
+const importCycleChainLimit = 16
+
+// reportImportCycles reports every cycle in the imports of the namespaces and
+// returns the qualified names of the namespaces, that are part of a cycle.
+func (compilation *packageCompilation) reportImportCycles(
+	namespaces []namespace.Namespace) map[string]bool {
+
+	cyclic := map[string]bool{}
+	graph := compilation.createImportGraph(namespaces)
+	for _, cycle := range dependency.DetectCycles(graph) {
+		compilation.reportImportCycle(cycle)
+		for _, name := range listNamespacesInCycle(cycle) {
+			cyclic[name] = true
+		}
+	}
+	return cyclic
+}
+
+// createImportGraph creates a dependency for every namespace, that depends on
+// the namespaces of the package which are imported by any of its units.
+// Imports of namespaces, that are not part of the package, are ignored.
+func (compilation *packageCompilation) createImportGraph(
+	namespaces []namespace.Namespace) []*dependency.Dependency {
+
+	dependencies := map[string]*dependency.Dependency{}
+	graph := make([]*dependency.Dependency, len(namespaces))
+	for index, namespace := range namespaces {
+		created := dependency.NewLocal(namespace.QualifiedName())
+		dependencies[namespace.QualifiedName()] = created
+		graph[index] = created
+	}
+	for _, namespace := range namespaces {
+		importing := dependencies[namespace.QualifiedName()]
+		for _, name := range compilation.listImportedNamespaces(namespace) {
+			if imported, ok := dependencies[name]; ok {
+				importing.AddDependency(imported)
+			}
+		}
+	}
+	return graph
+}
+
+// listImportedNamespaces lists the distinct namespaces, that are imported by
+// the units of the namespace.
+func (compilation *packageCompilation) listImportedNamespaces(
+	namespace namespace.Namespace) (names []string) {
+
+	listed := map[string]bool{}
+	for _, unit := range compilation.units[namespace.QualifiedName()] {
+		for _, statement := range unit.Imports {
+			name := statement.ImportedNamespace()
+			if !listed[name] {
+				listed[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// sortNamespaces sorts the namespaces into the order of their imports, every
+// namespace follows the namespaces that it imports. Namespaces, which are part
+// of a cycle or import one, can not be sorted and are returned as blocked.
+func (compilation *packageCompilation) sortNamespaces(
+	namespaces []namespace.Namespace) (ordered []namespace.Namespace, blocked []namespace.Namespace) {
+
+	byName := map[string]namespace.Namespace{}
+	for _, namespace := range namespaces {
+		byName[namespace.QualifiedName()] = namespace
+	}
+	graph := compilation.createImportGraph(namespaces)
+	sorted, unsortable := dependency.SortTopologically(graph)
+	for _, dependency := range sorted {
+		ordered = append(ordered, byName[dependency.Name()])
+	}
+	for _, dependency := range unsortable {
+		blocked = append(blocked, byName[dependency.Name()])
+	}
+	return ordered, blocked
+}
+
+// reportBlockedNamespaces reports the namespaces, that are not compiled since
+// they import a cyclic namespace, directly or through other namespaces. The
+// cyclic namespaces themselves have already been reported.
+func (compilation *packageCompilation) reportBlockedNamespaces(
+	blocked []namespace.Namespace, cyclic map[string]bool) {
+
+	isBlocked := map[string]bool{}
+	for _, namespace := range blocked {
+		isBlocked[namespace.QualifiedName()] = true
+	}
+	for _, namespace := range blocked {
+		if !cyclic[namespace.QualifiedName()] {
+			compilation.reportBlockedNamespace(namespace, isBlocked)
+		}
+	}
+}
+
+// reportBlockedNamespace reports the namespace at the first import of another
+// blocked or cyclic namespace.
+func (compilation *packageCompilation) reportBlockedNamespace(
+	blocked namespace.Namespace, isBlocked map[string]bool) {
+
+	for _, unit := range compilation.units[blocked.QualifiedName()] {
+		for _, statement := range unit.Imports {
+			imported := statement.ImportedNamespace()
+			if isBlocked[imported] {
+				message := diagnostic.FormatMessage(
+					CodeBlockedNamespace, blocked.QualifiedName(), imported)
+				compilation.recordImportDiagnostic(unit, statement, CodeBlockedNamespace, message)
+				return
+			}
+		}
+	}
+}
+
+func listNamespacesInCycle(cycle dependency.DependChain) (names []string) {
+	for index := 0; index < cycle.Length(); index++ {
+		names = append(names, cycle.At(index).Name())
+	}
+	return names
+}
+
+// reportImportCycle reports the cycle at the import statement, with which the
+// first namespace of the cycle imports the second one.
+func (compilation *packageCompilation) reportImportCycle(cycle dependency.DependChain) {
+	message := formatImportCycle(cycle)
+	importing, imported := cycle.Root().Name(), cycle.At(1).Name()
+	for _, unit := range compilation.units[importing] {
+		if statement, ok := findImportOfNamespace(unit, imported); ok {
+			compilation.recordImportDiagnostic(unit, statement, CodeImportCycle, message)
+			return
+		}
+	}
+}
+
+// formatImportCycle formats the cycle in the selected locale. Every element
+// of the chain is written on a line of its own.
+func formatImportCycle(cycle dependency.DependChain) string {
+	elements := cycle.ListLimited(importCycleChainLimit)
+	lines := make([]string, len(elements))
+	lines[0] = elements[0].Id()
+	for index, element := range elements[1:] {
+		lines[index+1] = diagnostic.FormatMessage(
+			CodeImportCycle.Qualify("reference"), element.Id())
+	}
+	return diagnostic.FormatMessage(
+		CodeImportCycle, cycle.At(1).Name(), strings.Join(lines, "\n"))
+}
+
+func findImportOfNamespace(
+	unit *tree.TranslationUnit, name string) (*tree.ImportStatement, bool) {
+
+	for _, statement := range unit.Imports {
+		if statement.ImportedNamespace() == name {
+			return statement, true
+		}
+	}
+	return nil, false
+}
+
+func (compilation *packageCompilation) recordImportDiagnostic(
+	unit *tree.TranslationUnit,
+	statement *tree.ImportStatement,
+	code diagnostic.Code,
+	message string) {
+
+	recorder := diagnostic.NewBag()
+	recorder.Record(diagnostic.RecordedEntry{
+		Kind:     &diagnostic.Error,
+		Stage:    &diagnostic.SemanticAnalysis,
+		Message:  message,
+		Code:     code,
+		UnitName: unit.Name,
+		Position: statement.Locate(),
+	})
+	converter := diagnostic.ConvertWithLineMap(unit.LineMap)
+	compilation.addDiagnostics(recorder.CreateDiagnostics(converter))
+}
@@ -0,0 +1,131 @@
+package buildtool
+
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/buildtool/namespace"
+	"github.com/strict-lang/sdk/pkg/compiler/backend"
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/syntax"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+)
+
+type testNamespace struct {
+	qualifiedName string
+}
+
+func (namespace *testNamespace) Name() string               { return namespace.qualifiedName }
+func (namespace *testNamespace) QualifiedName() string      { return namespace.qualifiedName }
+func (namespace *testNamespace) Entries() []namespace.Entry { return nil }
+func (namespace *testNamespace) MarkAsCompiled()            {}
+func (namespace *testNamespace) IsCompiled() bool           { return false }
+
+func parseTestUnit(testing *testing.T, name string, code string) *tree.TranslationUnit {
+	result := syntax.ParseString(name, code)
+	if result.Error != nil {
+		testing.Fatalf("failed to parse %s: %v", name, result.Error)
+	}
+	return result.TranslationUnit
+}
+
+func TestReportImportCycles(testing *testing.T) {
+	compilation := newPackageCompilation(nil, "", backend.Options{}, namespace.NewTable(), nil)
+	namespaces := []namespace.Namespace{
+		&testNamespace{qualifiedName: "Strict.First"},
+		&testNamespace{qualifiedName: "Strict.Second"},
+		&testNamespace{qualifiedName: "Strict.Third"},
+	}
+	compilation.units["Strict.First"] = []*tree.TranslationUnit{
+		parseTestUnit(testing, "First", "import Strict.Second\n"),
+	}
+	compilation.units["Strict.Second"] = []*tree.TranslationUnit{
+		parseTestUnit(testing, "Second", "import Strict.First\n"),
+	}
+	compilation.units["Strict.Third"] = []*tree.TranslationUnit{
+		parseTestUnit(testing, "Third", "import Strict.First\n"),
+	}
+	cyclic := compilation.reportImportCycles(namespaces)
+	if !cyclic["Strict.First"] || !cyclic["Strict.Second"] || cyclic["Strict.Third"] {
+		testing.Errorf("unexpected cyclic namespaces %v", cyclic)
+	}
+	entries := compilation.diagnostics.ListEntries()
+	if len(entries) != 1 {
+		testing.Fatalf("expected one diagnostic but got %d", len(entries))
+	}
+	expected := "cyclic import of namespace Strict.Second:\n" +
+		"Strict.First\nreferences Strict.Second\nreferences Strict.First"
+	if entries[0].Message != expected || entries[0].Kind != &diagnostic.Error {
+		testing.Errorf("unexpected diagnostic %q", entries[0].Message)
+	}
+	if entries[0].UnitName != "First" {
+		testing.Errorf("cycle reported in unit %s, expected First", entries[0].UnitName)
+	}
+}
+
+func TestReportImportCycles_FormatsInSelectedLocale(testing *testing.T) {
+	if err := diagnostic.SelectLocale("de"); err != nil {
+		testing.Fatal(err)
+	}
+	defer diagnostic.SelectLocale(diagnostic.DefaultLocale)
+	compilation := newPackageCompilation(nil, "", backend.Options{}, namespace.NewTable(), nil)
+	namespaces := []namespace.Namespace{
+		&testNamespace{qualifiedName: "Strict.First"},
+		&testNamespace{qualifiedName: "Strict.Second"},
+	}
+	compilation.units["Strict.First"] = []*tree.TranslationUnit{
+		parseTestUnit(testing, "First", "import Strict.Second\n"),
+	}
+	compilation.units["Strict.Second"] = []*tree.TranslationUnit{
+		parseTestUnit(testing, "Second", "import Strict.First\n"),
+	}
+	compilation.reportImportCycles(namespaces)
+	entries := compilation.diagnostics.ListEntries()
+	if len(entries) != 1 {
+		testing.Fatalf("expected one diagnostic but got %d", len(entries))
+	}
+	expected := "Zyklischer Import des Namensraums Strict.Second:\n" +
+		"Strict.First\nreferenziert Strict.Second\nreferenziert Strict.First"
+	if entries[0].Message != expected {
+		testing.Errorf("unexpected diagnostic %q", entries[0].Message)
+	}
+}
+
+func TestSortNamespaces_OrdersByImportsAndReportsBlocked(testing *testing.T) {
+	compilation := newPackageCompilation(nil, "", backend.Options{}, namespace.NewTable(), nil)
+	namespaces := []namespace.Namespace{
+		&testNamespace{qualifiedName: "Strict.Alpha"},
+		&testNamespace{qualifiedName: "Strict.Beta"},
+		&testNamespace{qualifiedName: "Strict.First"},
+		&testNamespace{qualifiedName: "Strict.Second"},
+		&testNamespace{qualifiedName: "Strict.Third"},
+	}
+	compilation.units["Strict.Alpha"] = []*tree.TranslationUnit{
+		parseTestUnit(testing, "Alpha", "import Strict.Beta\n"),
+	}
+	compilation.units["Strict.First"] = []*tree.TranslationUnit{
+		parseTestUnit(testing, "First", "import Strict.Second\n"),
+	}
+	compilation.units["Strict.Second"] = []*tree.TranslationUnit{
+		parseTestUnit(testing, "Second", "import Strict.First\n"),
+	}
+	compilation.units["Strict.Third"] = []*tree.TranslationUnit{
+		parseTestUnit(testing, "Third", "import Strict.First\n"),
+	}
+	cyclic := compilation.reportImportCycles(namespaces)
+	ordered, blocked := compilation.sortNamespaces(namespaces)
+	compilation.reportBlockedNamespaces(blocked, cyclic)
+	if len(ordered) != 2 || ordered[0].QualifiedName() != "Strict.Beta" ||
+		ordered[1].QualifiedName() != "Strict.Alpha" {
+		testing.Errorf("expected Strict.Beta to be compiled before Strict.Alpha, got %v", ordered)
+	}
+	if len(blocked) != 3 {
+		testing.Errorf("expected the cycle and Strict.Third to be blocked, got %v", blocked)
+	}
+	entries := compilation.diagnostics.ListEntries()
+	if len(entries) != 2 {
+		testing.Fatalf("expected two diagnostics but got %d", len(entries))
+	}
+	if entries[1].Code != CodeBlockedNamespace || entries[1].UnitName != "Third" {
+		testing.Errorf("expected Strict.Third to be reported as blocked, got %+v", entries[1])
+	}
+}
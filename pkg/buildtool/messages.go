@@ -0,0 +1,18 @@
+package buildtool
+
+import "github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+
+func init() {
+	diagnostic.RegisterMessages(diagnostic.DefaultLocale, map[diagnostic.Code]string{
+		CodeImportCycle:                      "cyclic import of namespace %s:\n%s",
+		CodeImportCycle.Qualify("reference"): "references %s",
+		CodeBlockedNamespace:                 "namespace %s is not compiled, since its import of %s leads into an import cycle",
+		CodeUnknownSeverity:                  "unknown severity %s of diagnostic %s",
+	})
+	diagnostic.RegisterMessages("de", map[diagnostic.Code]string{
+		CodeImportCycle:                      "Zyklischer Import des Namensraums %s:\n%s",
+		CodeImportCycle.Qualify("reference"): "referenziert %s",
+		CodeBlockedNamespace:                 "Der Namensraum %s wird nicht kompiliert, da sein Import von %s in einen Importzyklus führt",
+		CodeUnknownSeverity:                  "Unbekannter Schweregrad %s der Diagnose %s",
+	})
+}
@@ -7,25 +7,25 @@ import (
 	"github.com/strict-lang/sdk/pkg/compiler/analysis/semantic"
 	"github.com/strict-lang/sdk/pkg/compiler/backend"
 	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
-	"github.com/strict-lang/sdk/pkg/compiler/grammar/syntax"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
-	"github.com/strict-lang/sdk/pkg/compiler/input"
-	"github.com/strict-lang/sdk/pkg/compiler/input/linemap"
 	isolates "github.com/strict-lang/sdk/pkg/compiler/isolate"
+	"github.com/strict-lang/sdk/pkg/compiler/lowering"
 	"github.com/strict-lang/sdk/pkg/compiler/pass"
 	"github.com/strict-lang/sdk/pkg/compiler/scope"
 	"log"
-	"os"
 )
 
 func compileNamespace(
-	lineMaps *linemap.Table,
+	units []*tree.TranslationUnit,
 	backend backend.Backend,
+	backendName string,
+	options backend.Options,
 	namespace namespace.Namespace,
-	namespaces *namespace.Table) *diagnostic.Diagnostics {
+	namespaces *namespace.Table,
+	searchPath *analysis.SearchPath) *diagnostic.Diagnostics {
 
-
-	compilation := newNamespaceCompilation(lineMaps, backend, namespace, namespaces)
+	compilation := newNamespaceCompilation(
+		units, backend, backendName, options, namespace, namespaces, searchPath)
 	compilation.run()
 	return compilation.diagnostics
 }
@@ -37,22 +37,34 @@ type namespaceCompilation struct {
 	symbol      *scope.Namespace
 	namespace   namespace.Namespace
 	namespaces  *namespace.Table
+	searchPath  *analysis.SearchPath
 	backend     backend.Backend
-	lineMaps    *linemap.Table
+	backendName string
+	options     backend.Options
+	// isolates maps the units to the isolates, in which they are analysed.
+	// Units are lowered in the same isolate, after they have been analysed.
+	isolates map[*tree.TranslationUnit]*isolates.Isolate
 }
 
 func newNamespaceCompilation(
-	lineMaps *linemap.Table,
+	units []*tree.TranslationUnit,
 	backend backend.Backend,
+	backendName string,
+	options backend.Options,
 	namespace namespace.Namespace,
-	namespaces *namespace.Table) *namespaceCompilation {
+	namespaces *namespace.Table,
+	searchPath *analysis.SearchPath) *namespaceCompilation {
 
 	return &namespaceCompilation{
+		units: units,
 		namespace: namespace,
 		namespaces: namespaces,
+		searchPath: searchPath,
 		diagnostics: diagnostic.Empty(),
 		backend: backend,
-		lineMaps: lineMaps,
+		backendName: backendName,
+		options: options,
+		isolates: map[*tree.TranslationUnit]*isolates.Isolate{},
 	}
 }
 
@@ -85,6 +97,7 @@ func (compilation *namespaceCompilation) generateOutput(
 	output, err := compilation.backend.Generate(backend.Input{
 		Unit:        unit,
 		Diagnostics: diagnostic.NewBag(),
+		Options:     compilation.options,
 	})
 	if err != nil {
 		return err
@@ -98,11 +111,11 @@ func (compilation *namespaceCompilation) generateOutput(
 }
 
 func (compilation *namespaceCompilation) createNamespace() {
-	compilation.parseFiles()
 	compilation.symbol = compilation.createEmptyNamespace()
 	scope.GlobalNamespaceTable().Insert(compilation.symbol.QualifiedName, compilation.symbol)
 	compilation.runEarlyEnteringForAll()
 	compilation.completeAnalysisForAll()
+	compilation.lowerAll()
 }
 
 func (compilation *namespaceCompilation) completeAnalysisForAll() {
@@ -116,7 +129,7 @@ func (compilation *namespaceCompilation) completeAnalysis(unit *tree.Translation
 	context :=&pass.Context{
 		Unit:       unit,
 		Diagnostic: recorder,
-		Isolate:    isolates.New(),
+		Isolate:    compilation.isolates[unit],
 	}
 
 	if err := semantic.Run(context); err != nil {
@@ -126,6 +139,27 @@ func (compilation *namespaceCompilation) completeAnalysis(unit *tree.Translation
 	compilation.addDiagnostics(diagnostics)
 }
 
+func (compilation *namespaceCompilation) lowerAll() {
+	for _, unit := range compilation.units {
+		compilation.lower(unit)
+	}
+}
+
+// lower lowers the unit for the backend. The lowering reruns the name
+// resolution, its diagnostics have already been added by the analysis.
+func (compilation *namespaceCompilation) lower(unit *tree.TranslationUnit) {
+	isolate := compilation.isolates[unit]
+	lowering.SelectBackend(isolate, compilation.backendName, compilation.options)
+	context := &pass.Context{
+		Unit:       unit,
+		Diagnostic: diagnostic.NewBag(),
+		Isolate:    isolate,
+	}
+	if err := lowering.Run(context); err != nil {
+		log.Printf("could not run lowering: %s", err)
+	}
+}
+
 func (compilation *namespaceCompilation) runEarlyEnteringForAll() {
 	for _, unit := range compilation.units {
 		compilation.runEarlyEntering(unit)
@@ -153,9 +187,11 @@ func (compilation *namespaceCompilation) prepareIsolate(
 		Unit:       unit,
 		Namespaces: compilation.namespaces,
 		NamespaceSymbol: compilation.symbol,
+		SearchPath: compilation.searchPath,
 	}
 	isolate := isolates.New()
 	creation.Create().Store(isolate)
+	compilation.isolates[unit] = isolate
 	return isolate
 }
 
@@ -190,42 +226,6 @@ func (compilation *namespaceCompilation) addQualifierToName(name string) string
 	return name + "." + qualifier
 }
 
-func (compilation *namespaceCompilation) parseFiles() {
-	for _, entry := range compilation.namespace.Entries() {
-		if entry.IsDirectory() {
-			continue
-		}
-		unit, err := compilation.parseFileAtPath(entry.FileName())
-		if err != nil {
-			log.Printf("failed to compile %s, %v", entry.FileName(), err)
-			continue
-		}
-		compilation.units = append(compilation.units, unit)
-	}
-}
-
-func (compilation *namespaceCompilation) parseFileAtPath(
-	filePath string) (*tree.TranslationUnit, error) {
-
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, err
-	}
-	return compilation.parseFile(filePath, file)
-}
-
-func (compilation *namespaceCompilation) parseFile(
-	filePath string, file *os.File) (*tree.TranslationUnit, error) {
-
-	log.Printf("compiling file at path %s", filePath)
-	result := syntax.Parse(filePath, input.NewStreamReader(file))
-	compilation.addDiagnostics(result.Diagnostics)
-	if result.LineMap != nil {
-		compilation.lineMaps.Insert(filePath, result.LineMap)
-	}
-	return result.TranslationUnit, result.Error
-}
-
 
 func (compilation *namespaceCompilation) addDiagnostics(
 	diagnostics *diagnostic.Diagnostics) {
@@ -2,16 +2,27 @@ package buildtool
 
 import (
 	"github.com/strict-lang/sdk/pkg/buildtool/namespace"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis"
 	"github.com/strict-lang/sdk/pkg/compiler/backend"
 	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/syntax"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/input"
 	"github.com/strict-lang/sdk/pkg/compiler/input/linemap"
+	"log"
+	"os"
+	"sort"
 )
 
 func compilePackage(
 	backend backend.Backend,
-	namespaces *namespace.Table) packageCompilationResult {
+	backendName string,
+	options backend.Options,
+	namespaces *namespace.Table,
+	searchPath *analysis.SearchPath) packageCompilationResult {
 
-	compilation := newPackageCompilation(backend, namespaces)
+	compilation := newPackageCompilation(
+		backend, backendName, options, namespaces, searchPath)
 	compilation.run()
 	return packageCompilationResult{
 		diagnostics: compilation.diagnostics,
@@ -22,8 +33,13 @@ func compilePackage(
 type packageCompilation struct {
 	lineMaps *linemap.Table
 	backend backend.Backend
+	backendName string
+	options backend.Options
 	namespaces *namespace.Table
+	searchPath *analysis.SearchPath
 	diagnostics *diagnostic.Diagnostics
+	// units maps the qualified names of the namespaces to their parsed units.
+	units map[string][]*tree.TranslationUnit
 }
 
 type packageCompilationResult struct {
@@ -33,31 +49,101 @@ type packageCompilationResult struct {
 
 func newPackageCompilation(
 	backend backend.Backend,
-	namespaces *namespace.Table) *packageCompilation {
+	backendName string,
+	options backend.Options,
+	namespaces *namespace.Table,
+	searchPath *analysis.SearchPath) *packageCompilation {
 
 	return &packageCompilation{
 		lineMaps: linemap.NewEmptyTable(),
 		backend: backend,
+		backendName: backendName,
+		options: options,
 		namespaces:  namespaces,
+		searchPath:  searchPath,
 		diagnostics: diagnostic.Empty(),
+		units:       map[string][]*tree.TranslationUnit{},
 	}
 }
 
+// run parses every namespace before any of them is compiled, so that cyclic
+// imports are reported before they lead to partially resolved namespaces.
+// Namespaces are compiled after the namespaces, that they import. Namespaces
+// which are part of a cycle, or import one, are not compiled.
 func (compilation *packageCompilation) run() {
-	for _, namespace := range compilation.namespaces.List() {
+	namespaces := compilation.listNamespaces()
+	for _, namespace := range namespaces {
+		compilation.parseNamespace(namespace)
+	}
+	cyclic := compilation.reportImportCycles(namespaces)
+	ordered, blocked := compilation.sortNamespaces(namespaces)
+	compilation.reportBlockedNamespaces(blocked, cyclic)
+	for _, namespace := range ordered {
 		compilation.compileNamespace(namespace)
 	}
 }
 
+// listNamespaces lists the namespaces of the package, sorted by their
+// qualified names, so that they are always sorted into the same order.
+func (compilation *packageCompilation) listNamespaces() []namespace.Namespace {
+	namespaces := compilation.namespaces.List()
+	sort.Slice(namespaces, func(left, right int) bool {
+		return namespaces[left].QualifiedName() < namespaces[right].QualifiedName()
+	})
+	return namespaces
+}
+
+func (compilation *packageCompilation) parseNamespace(namespace namespace.Namespace) {
+	var units []*tree.TranslationUnit
+	for _, entry := range namespace.Entries() {
+		if entry.IsDirectory() {
+			continue
+		}
+		unit, err := compilation.parseFileAtPath(entry.FileName())
+		if err != nil {
+			log.Printf("failed to compile %s, %v", entry.FileName(), err)
+			continue
+		}
+		units = append(units, unit)
+	}
+	compilation.units[namespace.QualifiedName()] = units
+}
+
+func (compilation *packageCompilation) parseFileAtPath(
+	filePath string) (*tree.TranslationUnit, error) {
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return compilation.parseFile(filePath, file)
+}
+
+func (compilation *packageCompilation) parseFile(
+	filePath string, file *os.File) (*tree.TranslationUnit, error) {
+
+	log.Printf("compiling file at path %s", filePath)
+	result := syntax.Parse(filePath, input.NewStreamReader(file))
+	compilation.addDiagnostics(result.Diagnostics)
+	if result.LineMap != nil {
+		compilation.lineMaps.Insert(filePath, result.LineMap)
+	}
+	return result.TranslationUnit, result.Error
+}
+
 func (compilation *packageCompilation) compileNamespace(namespace namespace.Namespace) {
 	if namespace.IsCompiled() {
 		return
 	}
 	diagnostics := compileNamespace(
-		compilation.lineMaps,
+		compilation.units[namespace.QualifiedName()],
 		compilation.backend,
+		compilation.backendName,
+		compilation.options,
 		namespace,
-		compilation.namespaces)
+		compilation.namespaces,
+		compilation.searchPath)
 	compilation.addDiagnostics(diagnostics)
 	namespace.MarkAsCompiled()
 }
@@ -0,0 +1,110 @@
+package buildtool
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+)
+
+// Severity overrides the kind of the diagnostics with a code. Diagnostics can
+// be raised to errors, lowered to warnings or infos, or turned off entirely.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+	SeverityOff     Severity = "off"
+)
+
+var severityKinds = map[Severity]*diagnostic.Kind{
+	SeverityError:   &diagnostic.Error,
+	SeverityWarning: &diagnostic.Warning,
+	SeverityInfo:    &diagnostic.Info,
+	SeverityOff:     nil,
+}
+
+func (severity Severity) isValid() bool {
+	_, ok := severityKinds[severity]
+	return ok
+}
+
+// diagnosticPolicy decides which diagnostics of a build are reported and of
+// which kind they are. Diagnostics that are suppressed in their file, or whose
+// severity is off, are dropped. The kinds of the remaining diagnostics are
+// overridden by the configured severities and warnings are raised to errors,
+// if the build treats warnings as errors.
+type diagnosticPolicy struct {
+	severities       map[diagnostic.Code]Severity
+	warningsAsErrors bool
+	// suppressions maps the names of units to the suppressions in their file.
+	// They are read when the first diagnostic of the unit is checked.
+	suppressions map[string]*suppressions
+}
+
+func newDiagnosticPolicy(
+	severities map[diagnostic.Code]Severity, warningsAsErrors bool) *diagnosticPolicy {
+
+	normalized := map[diagnostic.Code]Severity{}
+	for code, severity := range severities {
+		normalized[diagnostic.Code(strings.ToUpper(string(code)))] = severity
+	}
+	return &diagnosticPolicy{
+		severities:       normalized,
+		warningsAsErrors: warningsAsErrors,
+		suppressions:     map[string]*suppressions{},
+	}
+}
+
+func (policy *diagnosticPolicy) apply(
+	diagnostics *diagnostic.Diagnostics) *diagnostic.Diagnostics {
+
+	var kept []diagnostic.Entry
+	for _, entry := range diagnostics.ListEntries() {
+		if policy.isSuppressed(entry) {
+			continue
+		}
+		if kind, ok := policy.selectKind(entry); ok {
+			entry.Kind = kind
+			kept = append(kept, entry)
+		}
+	}
+	return diagnostic.FromEntries(kept)
+}
+
+// selectKind selects the kind of the entry. It returns false if the entry is
+// turned off and should not be reported.
+func (policy *diagnosticPolicy) selectKind(entry diagnostic.Entry) (*diagnostic.Kind, bool) {
+	kind := entry.Kind
+	if severity, ok := policy.severities[entry.Code]; ok {
+		kind = severityKinds[severity]
+	}
+	if kind == nil {
+		return nil, false
+	}
+	if policy.warningsAsErrors && kind == &diagnostic.Warning {
+		return &diagnostic.Error, true
+	}
+	return kind, true
+}
+
+func (policy *diagnosticPolicy) isSuppressed(entry diagnostic.Entry) bool {
+	if entry.Code == "" || entry.UnitName == "" {
+		return false
+	}
+	offset := int(entry.Position.Begin.Offset)
+	return policy.lookupSuppressions(entry.UnitName).isSuppressed(entry.Code, offset)
+}
+
+// lookupSuppressions returns the suppressions in the file of the unit. Files
+// that can not be read have no suppressions.
+func (policy *diagnosticPolicy) lookupSuppressions(unitName string) *suppressions {
+	if found, ok := policy.suppressions[unitName]; ok {
+		return found
+	}
+	content, _ := ioutil.ReadFile(unitName)
+	parsed := parseSuppressions(string(content))
+	policy.suppressions[unitName] = parsed
+	return parsed
+}
@@ -0,0 +1,153 @@
+package buildtool
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+)
+
+// Diagnostics are suppressed by annotating the code with comments. The
+// suppressFile annotation suppresses the codes in the whole file, while the
+// suppress annotation only suppresses them in the declaration that follows:
+//
+//	// @suppressFile S0804
+//
+//	// @suppress S0801, S0803
+//	method run(unused Number)
+//	  let ignored = 0
+const (
+	suppressAnnotation     = "@suppress"
+	suppressFileAnnotation = "@suppressFile"
+)
+
+// suppressions are the codes, that are suppressed in a single file.
+type suppressions struct {
+	file    map[diagnostic.Code]bool
+	regions []suppressedRegion
+}
+
+// suppressedRegion is the region of a declaration, in which the codes are
+// suppressed. Its offsets are the indices of runes in the file.
+type suppressedRegion struct {
+	begin int
+	end   int
+	codes map[diagnostic.Code]bool
+}
+
+func (suppressions *suppressions) isSuppressed(code diagnostic.Code, offset int) bool {
+	if suppressions.file[code] {
+		return true
+	}
+	for _, region := range suppressions.regions {
+		if region.codes[code] && offset >= region.begin && offset <= region.end {
+			return true
+		}
+	}
+	return false
+}
+
+type sourceLine struct {
+	offset int
+	text   string
+	length int
+}
+
+func (line sourceLine) end() int {
+	return line.offset + line.length
+}
+
+// parseSuppressions parses the suppression annotations of the files content.
+// An annotated declaration spans its first line and every following line,
+// that is indented deeper.
+func parseSuppressions(content string) *suppressions {
+	parsed := &suppressions{file: map[diagnostic.Code]bool{}}
+	lines := splitLines(content)
+	var pending map[diagnostic.Code]bool
+	for index, line := range lines {
+		annotation, codes, ok := parseAnnotation(line.text)
+		switch {
+		case ok && annotation == suppressFileAnnotation:
+			addCodes(parsed.file, codes)
+		case ok:
+			if pending == nil {
+				pending = map[diagnostic.Code]bool{}
+			}
+			addCodes(pending, codes)
+		case isBlankOrComment(line.text):
+			continue
+		case pending != nil:
+			parsed.regions = append(parsed.regions, suppressedRegion{
+				begin: line.offset,
+				end:   findDeclarationEnd(lines, index),
+				codes: pending,
+			})
+			pending = nil
+		}
+	}
+	return parsed
+}
+
+func addCodes(target map[diagnostic.Code]bool, codes []diagnostic.Code) {
+	for _, code := range codes {
+		target[code] = true
+	}
+}
+
+func splitLines(content string) (lines []sourceLine) {
+	offset := 0
+	for _, text := range strings.Split(content, "\n") {
+		length := len([]rune(text))
+		lines = append(lines, sourceLine{offset: offset, text: text, length: length})
+		offset += length + 1
+	}
+	return lines
+}
+
+// parseAnnotation parses a comment, that consists of an annotation followed
+// by a list of codes. The codes are separated by spaces or commas.
+func parseAnnotation(text string) (string, []diagnostic.Code, bool) {
+	trimmed := strings.TrimSpace(text)
+	if !strings.HasPrefix(trimmed, "//") {
+		return "", nil, false
+	}
+	fields := strings.FieldsFunc(trimmed[2:], func(character rune) bool {
+		return unicode.IsSpace(character) || character == ','
+	})
+	if len(fields) == 0 {
+		return "", nil, false
+	}
+	annotation := fields[0]
+	if annotation != suppressAnnotation && annotation != suppressFileAnnotation {
+		return "", nil, false
+	}
+	codes := make([]diagnostic.Code, len(fields)-1)
+	for index, field := range fields[1:] {
+		codes[index] = diagnostic.Code(strings.ToUpper(field))
+	}
+	return annotation, codes, true
+}
+
+func isBlankOrComment(text string) bool {
+	trimmed := strings.TrimSpace(text)
+	return trimmed == "" || strings.HasPrefix(trimmed, "//")
+}
+
+func findDeclarationEnd(lines []sourceLine, index int) int {
+	indent := measureIndent(lines[index].text)
+	end := lines[index].end()
+	for _, line := range lines[index+1:] {
+		if isBlankOrComment(line.text) {
+			continue
+		}
+		if measureIndent(line.text) <= indent {
+			break
+		}
+		end = line.end()
+	}
+	return end
+}
+
+func measureIndent(text string) int {
+	return len(text) - len(strings.TrimLeft(text, " \t"))
+}
@@ -0,0 +1,115 @@
+package buildtool
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+)
+
+const suppressedCode = `// @suppressFile s0804
+
+// @suppress S0801, S0803
+method run(unused Number)
+  let ignored = 0
+
+  return
+
+method other()
+  let kept = 0
+`
+
+func offsetOf(testing *testing.T, text string) int {
+	index := strings.Index(suppressedCode, text)
+	if index < 0 {
+		testing.Fatalf("code does not contain %q", text)
+	}
+	return len([]rune(suppressedCode[:index]))
+}
+
+func TestParseSuppressions(testing *testing.T) {
+	parsed := parseSuppressions(suppressedCode)
+	entries := []struct {
+		code       diagnostic.Code
+		text       string
+		suppressed bool
+	}{
+		{code: "S0804", text: "method other", suppressed: true},
+		{code: "S0801", text: "unused", suppressed: true},
+		{code: "S0803", text: "ignored", suppressed: true},
+		{code: "S0803", text: "return", suppressed: true},
+		{code: "S0802", text: "ignored", suppressed: false},
+		{code: "S0803", text: "kept", suppressed: false},
+		{code: "S0801", text: "method other", suppressed: false},
+	}
+	for _, entry := range entries {
+		offset := offsetOf(testing, entry.text)
+		if parsed.isSuppressed(entry.code, offset) != entry.suppressed {
+			testing.Errorf("expected suppression of %s at %q to be %v",
+				entry.code, entry.text, entry.suppressed)
+		}
+	}
+}
+
+func createPolicyEntry(code diagnostic.Code, kind *diagnostic.Kind, offset int) diagnostic.Entry {
+	return diagnostic.Entry{
+		Kind:     kind,
+		Code:     code,
+		UnitName: "Test.strict",
+		Position: diagnostic.Position{
+			Begin: input.Position{Offset: input.Offset(offset)},
+			End:   input.Position{Offset: input.Offset(offset)},
+		},
+	}
+}
+
+func TestDiagnosticPolicy(testing *testing.T) {
+	policy := newDiagnosticPolicy(map[diagnostic.Code]Severity{
+		"s0101": SeverityWarning,
+		"S0802": SeverityError,
+		"S0805": SeverityOff,
+	}, true)
+	policy.suppressions["Test.strict"] = parseSuppressions(suppressedCode)
+	diagnostics := diagnostic.FromEntries([]diagnostic.Entry{
+		createPolicyEntry("S0101", &diagnostic.Error, 0),
+		createPolicyEntry("S0802", &diagnostic.Info, 0),
+		createPolicyEntry("S0805", &diagnostic.Error, 0),
+		createPolicyEntry("S0801", &diagnostic.Warning, offsetOf(testing, "unused")),
+		createPolicyEntry("S0801", &diagnostic.Warning, offsetOf(testing, "kept")),
+	})
+	entries := policy.apply(diagnostics).ListEntries()
+	if len(entries) != 3 {
+		testing.Fatalf("expected 3 entries but got %d", len(entries))
+	}
+	expected := []diagnostic.Code{"S0101", "S0802", "S0801"}
+	for index, entry := range entries {
+		if entry.Code != expected[index] || entry.Kind != &diagnostic.Error {
+			testing.Errorf("unexpected %s entry with code %s", entry.Kind.Name, entry.Code)
+		}
+	}
+}
+
+func TestDiagnosticPolicyKeepsWarnings(testing *testing.T) {
+	policy := newDiagnosticPolicy(map[diagnostic.Code]Severity{
+		"S0101": SeverityInfo,
+	}, false)
+	diagnostics := diagnostic.FromEntries([]diagnostic.Entry{
+		createPolicyEntry("S0101", &diagnostic.Error, 0),
+		createPolicyEntry("S0801", &diagnostic.Warning, 0),
+	})
+	entries := policy.apply(diagnostics).ListEntries()
+	if len(entries) != 2 ||
+		entries[0].Kind != &diagnostic.Info || entries[1].Kind != &diagnostic.Warning {
+		testing.Errorf("unexpected entries %v", entries)
+	}
+}
+
+func TestValidateConfiguration(testing *testing.T) {
+	configuration := Configuration{
+		Diagnostics: map[diagnostic.Code]Severity{"S0801": "fatal"},
+	}
+	if err := configuration.validate(); err == nil {
+		testing.Error("expected unknown severity to be rejected")
+	}
+}
@@ -10,6 +10,14 @@ import (
 
 type Analysis struct {
 	ImportScope scope.Scope
+	// NamespaceScope is the scope of the namespace that contains the analysed
+	// unit. Symbols that are inserted into it, like extension methods, are
+	// visible to every unit of the namespace and to importing namespaces.
+	NamespaceScope scope.Scope
+	// UnresolvedImports are the import statements of the unit, whose
+	// namespaces could neither be found in the package nor on the search
+	// path. They are reported by the name resolution.
+	UnresolvedImports []*tree.ImportStatement
 }
 
 func (analysis *Analysis) Store(isolate *isolate.Isolate) {
@@ -28,51 +36,80 @@ func RequireInIsolate(isolate *isolate.Isolate) *Analysis {
 	return nil
 }
 
+// CreateStandalone creates the analysis of a unit, that is compiled on its
+// own instead of as a part of a package. It can only refer to its own class
+// and to the builtins.
+func CreateStandalone(unit *tree.TranslationUnit) *Analysis {
+	unitScope := scope.NewOuterScope(scope.Id(unit.Name), scope.NewBuiltinScope())
+	unitScope.Insert(&scope.Class{
+		DeclarationName: unit.Class.Name,
+		QualifiedName:   unit.Class.Name,
+	})
+	return &Analysis{ImportScope: unitScope, NamespaceScope: unitScope}
+}
+
 type Creation struct {
 	Unit       *tree.TranslationUnit
 	Namespaces *namespace.Table
 	NamespaceSymbol *scope.Namespace
+	// SearchPath is searched for the descriptors of imported namespaces, that
+	// have not been compiled or imported yet.
+	SearchPath *SearchPath
 }
 
 func (creation *Creation) Create() *Analysis {
-	importScope := creation.createImportScope()
-	return &Analysis{importScope}
+	namespaces, unresolved := creation.resolveAllNamespaces()
+	return &Analysis{
+		ImportScope:       creation.createImportScope(namespaces),
+		NamespaceScope:    creation.NamespaceSymbol.Scope,
+		UnresolvedImports: unresolved,
+	}
 }
 
-func (creation *Creation) createImportScope() scope.Scope {
-	namespaces := append(creation.resolveAllNamespaces(), creation.NamespaceSymbol)
+func (creation *Creation) createImportScope(namespaces []scope.Symbol) scope.Scope {
+	namespaces = append(namespaces, creation.NamespaceSymbol)
 	importScope := scope.NewImportScope(creation.Unit.Name, namespaces)
 	namespaceScope := creation.NamespaceSymbol.Scope
 	return scope.Combine(importScope.Id(), namespaceScope, importScope)
 }
 
-func (creation *Creation) listImportedNamespaces() (namespaces []string) {
-	for _, statement := range creation.Unit.Imports {
-		namespaces = append(namespaces, statement.Target.Namespace())
-	}
-	return namespaces
-}
+// resolveAllNamespaces resolves the namespaces of the units imports. The
+// statements, whose namespace can not be resolved, are returned separately.
+func (creation *Creation) resolveAllNamespaces() (
+	symbols []scope.Symbol, unresolved []*tree.ImportStatement) {
 
-func (creation *Creation) resolveAllNamespaces() (symbols []scope.Symbol) {
-	namespaces := creation.listImportedNamespaces()
-	for _, symbol := range namespaces {
-		symbols = append(symbols, creation.resolveNamespace(symbol))
+	for _, statement := range creation.Unit.Imports {
+		name := statement.ImportedNamespace()
+		if symbol, ok := creation.resolveNamespace(name); ok {
+			symbols = append(symbols, symbol)
+		} else {
+			unresolved = append(unresolved, statement)
+		}
 	}
-	return symbols
+	return symbols, unresolved
 }
 
-func (creation *Creation) resolveNamespace(namespace string) scope.Symbol {
+// resolveNamespace looks up the namespace in the global namespace table and
+// imports it, if it is not yet known. Imported namespaces are cached in the
+// table, so that their descriptors are only decoded once.
+func (creation *Creation) resolveNamespace(name string) (*scope.Namespace, bool) {
 	cache := scope.GlobalNamespaceTable()
-	if symbol, ok := cache.Lookup(namespace); ok {
-		return symbol
+	if symbol, ok := cache.Lookup(name); ok {
+		return symbol, true
+	}
+	createdNamespace, ok := creation.importNamespace(name)
+	if ok {
+		cache.Insert(name, createdNamespace)
 	}
-	createdNamespace := creation.importNamespace(namespace)
-	cache.Insert(namespace, createdNamespace)
-	return createdNamespace
+	return createdNamespace, ok
 }
 
-func (creation *Creation) importNamespace(namespace string) *scope.Namespace {
-	log.Printf("don't know how to import namespace: " + namespace)
-	return nil
+func (creation *Creation) importNamespace(name string) (*scope.Namespace, bool) {
+	descriptor, err := creation.SearchPath.Locate(name)
+	if err != nil {
+		log.Printf("could not import namespace %s: %v", name, err)
+		return nil, false
+	}
+	return enterNamespace(name, descriptor), true
 }
 
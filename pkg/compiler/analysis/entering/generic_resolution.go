@@ -62,7 +62,7 @@ func (pass *GenericResolutionPass) visitGenericLetBindingInTypeName(
 func (pass *GenericResolutionPass) visitTopLevelLetBindings(class *tree.ClassDeclaration) {
 	var newChildren []tree.Node
 	for _, child := range class.Children {
-		if binding, isBinding := child.(*tree.LetBinding); isBinding {
+		if binding, isBinding := asLetBinding(child); isBinding {
 			if isTopLevelBinding(binding) && isGenericBinding(binding) {
 				pass.addParameters(binding)
 				continue
@@ -73,11 +73,23 @@ func (pass *GenericResolutionPass) visitTopLevelLetBindings(class *tree.ClassDec
 	class.Children = newChildren
 }
 
+// asLetBinding returns the let binding of the node. Bindings at the top level
+// of a class are wrapped into expression statements.
+func asLetBinding(node tree.Node) (*tree.LetBinding, bool) {
+	if statement, ok := node.(*tree.ExpressionStatement); ok {
+		node = statement.Expression
+	}
+	binding, ok := node.(*tree.LetBinding)
+	return binding, ok
+}
+
 func (pass *GenericResolutionPass) addParameters(binding *tree.LetBinding) {
+	bound := findGenericBound(binding)
 	for _, identifier := range binding.Names {
 		parameters := &tree.ClassParameter{
-			Name:   identifier.Value,
-			Parent: pass.currentClass,
+			Name:      identifier.Value,
+			SuperType: bound,
+			Parent:    pass.currentClass,
 		}
 		pass.currentClass.Parameters = append(pass.currentClass.Parameters, parameters)
 	}
@@ -85,13 +97,40 @@ func (pass *GenericResolutionPass) addParameters(binding *tree.LetBinding) {
 
 const genericKeyword = "generic"
 
+// isGenericBinding returns true if the binding declares type parameters.
+// Parameters are either declared as `let T = generic` or, if they are
+// bounded by a trait, as `let T = generic(Trait)`.
 func isGenericBinding(binding *tree.LetBinding) bool {
-	if identifier, isIdentifier := binding.Expression.(*tree.Identifier); isIdentifier {
-		return identifier.Value == genericKeyword
+	switch expression := binding.Expression.(type) {
+	case *tree.Identifier:
+		return expression.Value == genericKeyword
+	case *tree.CallExpression:
+		_, ok := findGenericBoundInCall(expression)
+		return ok
 	}
 	return false
 }
 
+func findGenericBound(binding *tree.LetBinding) tree.TypeName {
+	if call, ok := binding.Expression.(*tree.CallExpression); ok {
+		if bound, ok := findGenericBoundInCall(call); ok {
+			return bound
+		}
+	}
+	return nil
+}
+
+func findGenericBoundInCall(call *tree.CallExpression) (tree.TypeName, bool) {
+	target, ok := call.Target.(*tree.Identifier)
+	if !ok || target.Value != genericKeyword || len(call.Arguments) != 1 {
+		return nil, false
+	}
+	if bound, ok := call.Arguments[0].Value.(*tree.Identifier); ok {
+		return &tree.ConcreteTypeName{Name: bound.Value, Region: bound.Region}, true
+	}
+	return nil, false
+}
+
 func isTopLevelBinding(binding *tree.LetBinding) bool {
-	return tree.IsInsideOfMethod(binding)
+	return !tree.IsInsideOfMethod(binding)
 }
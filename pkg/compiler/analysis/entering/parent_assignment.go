@@ -97,6 +97,9 @@ func createAssignmentVisitor() tree.Visitor {
 		PostfixExpressionVisitor: func(expression *tree.PostfixExpression) {
 			expression.Operand.SetEnclosingNode(expression)
 		},
+		PropagationExpressionVisitor: func(expression *tree.PropagationExpression) {
+			expression.Operand.SetEnclosingNode(expression)
+		},
 		GenericTypeNameVisitor: func(name *tree.GenericTypeName) {
 			for _, argument := range name.Arguments {
 				argument.Expression.SetEnclosingNode(name)
@@ -164,5 +167,37 @@ func createAssignmentVisitor() tree.Visitor {
 				name.SetEnclosingNode(binding)
 			}
 		},
+		UnionDeclarationVisitor: func(declaration *tree.UnionDeclaration) {
+			declaration.Name.SetEnclosingNode(declaration)
+			for _, unionCase := range declaration.Cases {
+				unionCase.Name.SetEnclosingNode(declaration)
+				for _, parameter := range unionCase.Payload {
+					parameter.SetEnclosingNode(declaration)
+				}
+			}
+		},
+		MatchStatementVisitor: func(statement *tree.MatchStatement) {
+			statement.Subject.SetEnclosingNode(statement)
+			for _, arm := range statement.Arms {
+				if !arm.IsDefault() {
+					arm.Case.SetEnclosingNode(statement)
+				}
+				arm.Body.SetEnclosingNode(statement)
+				for _, binding := range arm.Bindings {
+					binding.SetEnclosingNode(arm.Body)
+				}
+			}
+		},
+		ListExpressionVisitor: func(expression *tree.ListExpression) {
+			for _, child := range expression.Expressions {
+				child.SetEnclosingNode(expression)
+			}
+		},
+		MapExpressionVisitor: func(expression *tree.MapExpression) {
+			for _, entry := range expression.Entries {
+				entry.Key.SetEnclosingNode(expression)
+				entry.Value.SetEnclosingNode(expression)
+			}
+		},
 	}
 }
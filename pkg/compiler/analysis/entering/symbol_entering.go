@@ -1,6 +1,7 @@
 package entering
 
 import (
+	"github.com/strict-lang/sdk/pkg/compiler/analysis"
 	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
 	"github.com/strict-lang/sdk/pkg/compiler/isolate"
@@ -23,11 +24,17 @@ type SymbolEnterPass struct {
 	currentClass       *tree.ClassDeclaration
 	currentClassSymbol *scope.Class
 	currentUnit        *tree.TranslationUnit
+	namespaceScope     scope.Scope
+	// declarations are the names, that the entered symbols are declared with.
+	// They label the first declaration of names that collide.
+	declarations map[scope.Symbol]*tree.Identifier
 }
 
 func (pass *SymbolEnterPass) Run(context *passes.Context) {
 	visitor := pass.createVisitor()
 	pass.diagnostics = context.Diagnostic
+	pass.declarations = map[scope.Symbol]*tree.Identifier{}
+	pass.namespaceScope = analysis.RequireInIsolate(context.Isolate).NamespaceScope
 	context.Unit.AcceptRecursive(visitor)
 }
 
@@ -48,6 +55,7 @@ func (pass *SymbolEnterPass) createVisitor() tree.Visitor {
 	visitor.LetBindingVisitor = pass.visitLetBinding
 	visitor.ForEachLoopStatementVisitor = pass.visitForEachLoopStatement
 	visitor.RangedLoopStatementVisitor = pass.visitRangedLoopStatement
+	visitor.MatchStatementVisitor = pass.visitMatchStatement
 	return visitor
 }
 
@@ -60,6 +68,74 @@ func (pass *SymbolEnterPass) visitClassDeclaration(
 
 	pass.currentClass = declaration
 	pass.enterClassDeclaration(declaration)
+	pass.enterUnionDeclarations(declaration)
+}
+
+// enterUnionDeclarations enters the unions of the class before any of its
+// members, since members may refer to unions that are declared after them.
+// Every union is entered before the cases, so that payloads can refer to
+// any union of the class, including their own.
+func (pass *SymbolEnterPass) enterUnionDeclarations(
+	declaration *tree.ClassDeclaration) {
+
+	var unions []*tree.UnionDeclaration
+	var symbols []*scope.Class
+	for _, child := range declaration.Children {
+		if union, ok := child.(*tree.UnionDeclaration); ok {
+			if symbol, ok := pass.enterUnionDeclaration(union); ok {
+				unions = append(unions, union)
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+	for index, union := range unions {
+		pass.enterUnionCases(union, symbols[index])
+	}
+}
+
+func (pass *SymbolEnterPass) enterUnionDeclaration(
+	declaration *tree.UnionDeclaration) (*scope.Class, bool) {
+
+	declaration.Name.MarkAsPartOfDeclaration()
+	surroundingScope := requireNearestMutableScope(declaration)
+	name := declaration.Name.Value
+	if !pass.ensureNameDoesNotExist(name, declaration, surroundingScope) {
+		return nil, false
+	}
+	union := scope.NewUnionClass(name, surroundingScope)
+	surroundingScope.Insert(union)
+	pass.bindDeclaration(declaration.Name, union)
+	return union, true
+}
+
+// enterUnionCases enters the constructors of the unions cases into the
+// scope that surrounds the union. Values of a case are created by calling
+// its constructor with the payload.
+func (pass *SymbolEnterPass) enterUnionCases(
+	declaration *tree.UnionDeclaration, union *scope.Class) {
+
+	surroundingScope := requireNearestMutableScope(declaration)
+	for _, unionCase := range declaration.Cases {
+		unionCase.Name.MarkAsPartOfDeclaration()
+		name := unionCase.Name.Value
+		if pass.ensureNameDoesNotExist(name, declaration, surroundingScope) {
+			payload := pass.createUnionPayload(unionCase, surroundingScope)
+			constructor := scope.AddUnionCase(union, name, payload)
+			surroundingScope.Insert(constructor)
+			pass.bindDeclaration(unionCase.Name, constructor)
+		}
+	}
+}
+
+func (pass *SymbolEnterPass) createUnionPayload(
+	unionCase *tree.UnionCase, surroundingScope scope.MutableScope) []*scope.Field {
+
+	payload := make([]*scope.Field, len(unionCase.Payload))
+	for index, parameter := range unionCase.Payload {
+		parameter.Name.MarkAsPartOfDeclaration()
+		payload[index] = pass.newFieldSymbolFromParameter(parameter, surroundingScope)
+	}
+	return payload
 }
 
 func (pass *SymbolEnterPass) enterClassDeclaration(
@@ -72,6 +148,7 @@ func (pass *SymbolEnterPass) enterClassDeclaration(
 		pass.initializeClassSymbol(declaration, class)
 		pass.currentClassSymbol = class
 		pass.enterGenerics(declaration)
+		pass.enterTraits(declaration, class)
 		surroundingScope.Insert(class)
 	} else {
 		scope.Log(surroundingScope)
@@ -79,15 +156,19 @@ func (pass *SymbolEnterPass) enterClassDeclaration(
 	}
 }
 
+// enterGenerics enters the type parameters of the class into its scope. The
+// parameters are replaced by arguments, when the class is instantiated.
 func (pass *SymbolEnterPass) enterGenerics(declaration *tree.ClassDeclaration) {
+	targetScope := requireNearestMutableScope(declaration)
 	for _, parameter := range declaration.Parameters {
-		targetScope := requireNearestMutableScope(declaration)
-		targetScope.Insert(&scope.Class{
-			Scope:           targetScope,
-			DeclarationName: parameter.Name,
-			QualifiedName:   parameter.Name,
-			ActualClass:     &typing.ConcreteType{Name:   "Any"},
-		})
+		var bound *scope.Class
+		if parameter.SuperType != nil {
+			bound = pass.requireClass(parameter.SuperType, targetScope)
+		}
+		class := scope.NewTypeParameter(parameter.Name, bound)
+		targetScope.Insert(class)
+		pass.currentClassSymbol.Parameters = append(
+			pass.currentClassSymbol.Parameters, class)
 	}
 }
 
@@ -96,17 +177,115 @@ func (pass *SymbolEnterPass) initializeClassSymbol(
 	symbol *scope.Class) {
 
 	symbol.Scope = ensureScopeIsMutable(declaration.Scope())
-	symbol.ActualClass = declaration.NewActualClass()
+	if symbol.ActualClass == nil {
+		symbol.ActualClass = declaration.NewActualClass()
+	}
+}
+
+// enterTraits records the traits, that the class implements. Unknown traits
+// are skipped, they are reported by the TraitConformancePass.
+func (pass *SymbolEnterPass) enterTraits(
+	declaration *tree.ClassDeclaration, class *scope.Class) {
+
+	for _, name := range declaration.ImplementedTraits() {
+		point := scope.NewReferencePoint(name.BaseName())
+		if trait, ok := scope.LookupClass(declaration.Scope(), point); ok {
+			scope.AddTrait(class, trait)
+		}
+	}
 }
 
 func (pass *SymbolEnterPass) visitMethodDeclaration(
 	declaration *tree.MethodDeclaration) {
 
 	declaration.Name.MarkAsPartOfDeclaration()
+	if declaration.IsExtension() {
+		pass.visitExtensionMethodDeclaration(declaration)
+		return
+	}
+	parameterSymbols := pass.enterMethodParameters(declaration)
+	if symbol, ok := pass.enterMethodToSurroundingScope(declaration, parameterSymbols); ok {
+		pass.bindDeclaration(declaration.Name, symbol)
+		pass.maybeEnterPostconditionResult(declaration, symbol)
+	}
+}
+
+// maybeEnterPostconditionResult enters the implicit result field into the
+// scope of methods that declare postconditions and return a value. The field
+// can then be referenced by the `ensures` clauses.
+func (pass *SymbolEnterPass) maybeEnterPostconditionResult(
+	declaration *tree.MethodDeclaration, symbol *scope.Method) {
+
+	if len(declaration.Postconditions()) == 0 || symbol.ReturnType == scope.Builtins.Void {
+		return
+	}
+	methodScope := ensureScopeIsMutable(declaration.Scope())
+	point := scope.NewReferencePoint(scope.PostconditionResultName)
+	if !methodScope.Contains(point) {
+		methodScope.Insert(&scope.Field{
+			DeclarationName: scope.PostconditionResultName,
+			Class:           symbol.ReturnType,
+			Kind:            scope.VariableField,
+		})
+	}
+}
+
+// visitExtensionMethodDeclaration enters an extension method into the scope of
+// the surrounding namespace, instead of the scope of its class. This makes the
+// method visible to other units of the namespace and to importing namespaces.
+// The extended value is accessible through an implicit receiver parameter.
+func (pass *SymbolEnterPass) visitExtensionMethodDeclaration(
+	declaration *tree.MethodDeclaration) {
+
+	targetScope := pass.selectExtensionScope(declaration)
+	receiver := pass.requireClass(declaration.Extended, targetScope)
+	pass.enterExtensionReceiver(declaration, receiver)
 	parameterSymbols := pass.enterMethodParameters(declaration)
-	if symbol, ok := pass.enterMethodToSurroundingScope(declaration); ok {
+	if pass.ensureExtensionDoesNotExist(declaration, receiver, targetScope) {
+		symbol := pass.newMethodSymbol(declaration, targetScope)
 		symbol.Parameters = parameterSymbols
+		symbol.Receiver = receiver
+		targetScope.Insert(symbol)
+		pass.bindDeclaration(declaration.Name, symbol)
+		pass.maybeEnterPostconditionResult(declaration, symbol)
+	}
+}
+
+// selectExtensionScope returns the scope that extension methods are entered
+// into. If the namespace scope is not known or immutable, the method is only
+// visible inside of the declaring unit.
+func (pass *SymbolEnterPass) selectExtensionScope(
+	declaration *tree.MethodDeclaration) scope.MutableScope {
+
+	if namespaceScope, ok := pass.namespaceScope.(scope.MutableScope); ok {
+		return namespaceScope
+	}
+	return requireNearestMutableScope(declaration)
+}
+
+func (pass *SymbolEnterPass) enterExtensionReceiver(
+	declaration *tree.MethodDeclaration, receiver *scope.Class) {
+
+	methodScope := ensureScopeIsMutable(declaration.Scope())
+	methodScope.Insert(&scope.Field{
+		DeclarationName: scope.ExtensionReceiverName,
+		Class:           receiver,
+		Kind:            scope.ParameterField,
+	})
+}
+
+func (pass *SymbolEnterPass) ensureExtensionDoesNotExist(
+	declaration *tree.MethodDeclaration,
+	receiver *scope.Class,
+	targetScope scope.Scope) bool {
+
+	name := declaration.Name.Value
+	point := scope.NewReferencePoint(name)
+	if existing, exists := scope.LookupExtension(targetScope, receiver, point); exists {
+		pass.reportNameCollision(name, declaration, existing)
+		return false
 	}
+	return true
 }
 
 func (pass *SymbolEnterPass) enterMethodParameters(
@@ -128,6 +307,7 @@ func (pass *SymbolEnterPass) enterMethodParameter(
 	symbol := pass.newFieldSymbolFromParameter(parameter, methodScope)
 	if pass.ensureNameDoesNotExist(parameter.Name.Value, parameter, methodScope) {
 		methodScope.Insert(symbol)
+		pass.bindDeclaration(parameter.Name, symbol)
 		return symbol
 	}
 	return nil
@@ -146,17 +326,41 @@ func (pass *SymbolEnterPass) newFieldSymbolFromParameter(
 }
 
 func (pass *SymbolEnterPass) enterMethodToSurroundingScope(
-	method *tree.MethodDeclaration) (*scope.Method, bool) {
+	method *tree.MethodDeclaration,
+	parameters []*scope.Field) (*scope.Method, bool) {
 
 	surroundingScope := requireNearestMutableScope(method)
-	if pass.ensureNameDoesNotExist(method.Name.Value, method, surroundingScope) {
-		symbol := pass.newMethodSymbol(method, surroundingScope)
+	symbol := pass.newMethodSymbol(method, surroundingScope)
+	symbol.Parameters = parameters
+	if pass.ensureOverloadIsDistinct(symbol, method, surroundingScope) {
 		surroundingScope.Insert(symbol)
 		return symbol, true
 	}
 	return nil, false
 }
 
+// ensureOverloadIsDistinct ensures that the method can be entered into the
+// scope. Methods of the same class may share their name, if they can be
+// distinguished by the labels or classes of their parameters. Any other
+// symbol with the same name collides with the method.
+func (pass *SymbolEnterPass) ensureOverloadIsDistinct(
+	symbol *scope.Method,
+	method *tree.MethodDeclaration,
+	surroundingScope scope.Scope) bool {
+
+	point := scope.NewReferencePoint(symbol.Name())
+	for _, entry := range surroundingScope.Lookup(point) {
+		existing, ok := scope.AsMethodSymbol(entry.Symbol)
+		if !ok || existing.EnclosingClass != symbol.EnclosingClass ||
+			existing.IsExtension() || scope.HasSameSignature(existing, symbol) {
+
+			pass.reportNameCollision(symbol.Name(), method, entry.Symbol)
+			return false
+		}
+	}
+	return true
+}
+
 func (pass *SymbolEnterPass) newMethodSymbol(
 	method *tree.MethodDeclaration,
 	surroundingScope scope.MutableScope) *scope.Method {
@@ -165,6 +369,8 @@ func (pass *SymbolEnterPass) newMethodSymbol(
 	return &scope.Method{
 		DeclarationName: method.Name.Value,
 		ReturnType:      class,
+		EnclosingClass:  pass.currentClassSymbol,
+		Abstract:        method.Abstract,
 	}
 }
 
@@ -180,14 +386,84 @@ func (pass *SymbolEnterPass) newMethodSymbol(
 func (pass *SymbolEnterPass) requireClass(
 	name tree.TypeName, targetScope scope.MutableScope) *scope.Class {
 
+	if class, ok := pass.requireBuiltinGenericClass(name, targetScope); ok {
+		return class
+	}
 	returnTypePoint := scope.NewReferencePoint(name.BaseName())
 	if class, ok := scope.LookupClass(targetScope, returnTypePoint); ok {
+		if generic, ok := name.(*tree.GenericTypeName); ok {
+			return pass.instantiateGenericClass(class, generic, targetScope)
+		}
 		return class
 	}
 	pass.reportMissingClass(name)
 	return pass.createClassReplacementInScope(name, targetScope)
 }
 
+// requireBuiltinGenericClass resolves instantiations of the builtin generic
+// classes: lists, maps and results. Every instantiation gets its own class,
+// which knows the classes it is instantiated with.
+func (pass *SymbolEnterPass) requireBuiltinGenericClass(
+	name tree.TypeName, targetScope scope.MutableScope) (*scope.Class, bool) {
+
+	switch name := name.(type) {
+	case *tree.ListTypeName:
+		element := pass.requireClass(name.Element, targetScope)
+		return scope.NewListClass(element), true
+	case *tree.GenericTypeName:
+		return pass.requireBuiltinGenericTypeName(name, targetScope)
+	}
+	return nil, false
+}
+
+func (pass *SymbolEnterPass) requireBuiltinGenericTypeName(
+	name *tree.GenericTypeName, targetScope scope.MutableScope) (*scope.Class, bool) {
+
+	switch {
+	case isBuiltinGeneric(name, scope.ListClassName, 1):
+		element := pass.requireGenericArgument(name, 0, targetScope)
+		return scope.NewListClass(element), true
+	case isBuiltinGeneric(name, scope.ResultClassName, 1):
+		value := pass.requireGenericArgument(name, 0, targetScope)
+		return scope.NewResultClass(value), true
+	case isBuiltinGeneric(name, scope.MapClassName, 2):
+		key := pass.requireGenericArgument(name, 0, targetScope)
+		value := pass.requireGenericArgument(name, 1, targetScope)
+		return scope.NewMapClass(key, value), true
+	}
+	return nil, false
+}
+
+// instantiateGenericClass instantiates a generic class, that is declared in
+// the namespace or imported, with the arguments of the type name.
+func (pass *SymbolEnterPass) instantiateGenericClass(
+	class *scope.Class,
+	name *tree.GenericTypeName,
+	targetScope scope.MutableScope) *scope.Class {
+
+	arguments := make([]*scope.Class, len(name.Arguments))
+	for index := range name.Arguments {
+		arguments[index] = pass.requireGenericArgument(name, index, targetScope)
+	}
+	return scope.Instantiate(class, arguments)
+}
+
+func (pass *SymbolEnterPass) requireGenericArgument(
+	name *tree.GenericTypeName,
+	index int,
+	targetScope scope.MutableScope) *scope.Class {
+
+	argumentName := &tree.ConcreteTypeName{
+		Name:   name.Arguments[index].Name,
+		Region: name.Region,
+	}
+	return pass.requireClass(argumentName, targetScope)
+}
+
+func isBuiltinGeneric(name *tree.GenericTypeName, className string, arity int) bool {
+	return name.Name == className && len(name.Arguments) == arity
+}
+
 func (pass *SymbolEnterPass) reportMissingClass(name tree.TypeName) {
 	log.Printf("Class not found %s\n", name.FullName())
 }
@@ -208,6 +484,7 @@ func (pass *SymbolEnterPass) createClassReplacement(
 
 	return &scope.Class{
 		DeclarationName: name.BaseName(),
+		QualifiedName:   name.BaseName(),
 		Scope:           scope.NewOuterScope(scope.Id(name.BaseName()), parentScope),
 		ActualClass:     typing.NewEmptyClass(name.BaseName()),
 	}
@@ -225,6 +502,20 @@ func (pass *SymbolEnterPass) visitRangedLoopStatement(
 	pass.visitUntypedVariable(loop.Field, loop)
 }
 
+// visitMatchStatement enters the bindings of every arm into the scope of the
+// arms body. Their classes depend on the class of the matched value and are
+// therefore set during the name resolution.
+func (pass *SymbolEnterPass) visitMatchStatement(match *tree.MatchStatement) {
+	for _, arm := range match.Arms {
+		if !arm.IsDefault() {
+			arm.Case.MarkAsPartOfDeclaration()
+		}
+		for _, binding := range arm.Bindings {
+			pass.visitUntypedVariable(binding, binding)
+		}
+	}
+}
+
 func (pass *SymbolEnterPass) visitFieldDeclaration(
 	declaration *tree.FieldDeclaration) {
 
@@ -246,18 +537,27 @@ func (pass *SymbolEnterPass) enterFieldDeclaration(
 	}
 }
 
+// visitLetBinding enters the bound names as immutable variables.
 func (pass *SymbolEnterPass) visitLetBinding(binding *tree.LetBinding) {
 	for _, name := range binding.Names {
-		pass.visitUntypedVariable(name, binding)
+		if field, ok := pass.visitUntypedVariable(name, binding); ok {
+			field.Immutable = true
+		}
 	}
 }
 
-func (pass *SymbolEnterPass) visitUntypedVariable(name *tree.Identifier, node tree.Node) {
+func (pass *SymbolEnterPass) visitUntypedVariable(
+	name *tree.Identifier, node tree.Node) (*scope.Field, bool) {
+
 	name.MarkAsPartOfDeclaration()
 	surroundingScope := requireNearestMutableScope(node)
 	if pass.ensureNameDoesNotExist(name.Value, node, surroundingScope) {
-		surroundingScope.Insert(pass.createUntypedVariable(name.Value))
+		field := pass.createUntypedVariable(name.Value)
+		surroundingScope.Insert(field)
+		pass.bindDeclaration(name, field)
+		return field, true
 	}
+	return nil, false
 }
 
 func isVariable(declaration *tree.FieldDeclaration) bool {
@@ -277,13 +577,24 @@ func (pass *SymbolEnterPass) createMemberField(field *tree.FieldDeclaration) *sc
 func (pass *SymbolEnterPass) enterMemberField(
 	field *tree.FieldDeclaration, scope scope.MutableScope) {
 
-	scope.Insert(pass.createMemberField(field))
+	symbol := pass.createMemberField(field)
+	scope.Insert(symbol)
+	pass.bindDeclaration(field.Name, symbol)
 }
 
+// enterVariable enters a variable that is declared inside of a method. If the
+// declaration names the class of the variable, the field is typed. Otherwise
+// the field is entered without a class.
 func (pass *SymbolEnterPass) enterVariable(
 	variable *tree.FieldDeclaration, targetScope scope.MutableScope) {
 
-	targetScope.Insert(pass.createUntypedVariable(variable.Name.Value))
+	field := pass.createUntypedVariable(variable.Name.Value)
+	if !variable.Inferred && variable.TypeName != nil {
+		field.Class = pass.requireClass(variable.TypeName, targetScope)
+		variable.Name.ResolveType(field.Class)
+	}
+	pass.bindDeclaration(variable.Name, field)
+	targetScope.Insert(field)
 }
 
 func (pass *SymbolEnterPass) createUntypedVariable(name string) *scope.Field {
@@ -313,11 +624,40 @@ func (pass *SymbolEnterPass) reportNameCollision(
 	pass.diagnostics.Record(diagnostic.RecordedEntry{
 		Kind:     &diagnostic.Error,
 		Stage:    &diagnostic.SemanticAnalysis,
-		Message:  "collision for name " + name,
 		UnitName: pass.currentUnit.Name,
 		Error:    &diagnostic.RichError{
 			Error:         &diagnostic.NameCollisionError{Symbol: name},
 		},
 		Position: node.Locate(),
+		Labels:   pass.createDeclarationLabels(existingSymbol),
 	})
 }
+
+// createDeclarationLabels labels the name, that the existing symbol was first
+// declared with. Symbols that are declared in other units are not labeled.
+func (pass *SymbolEnterPass) createDeclarationLabels(
+	existingSymbol scope.Symbol) []diagnostic.Label {
+
+	if declaration, ok := pass.findDeclaringName(existingSymbol); ok {
+		key := diagnostic.CodeNameCollision.Qualify("declaration")
+		return []diagnostic.Label{{
+			Message:  diagnostic.FormatMessage(key),
+			Position: declaration.Locate(),
+		}}
+	}
+	return nil
+}
+
+func (pass *SymbolEnterPass) findDeclaringName(symbol scope.Symbol) (*tree.Identifier, bool) {
+	declaration, ok := pass.declarations[symbol]
+	return declaration, ok
+}
+
+// bindDeclaration binds the name, that declares the symbol, to it. The first
+// name of every symbol is recorded, so that collisions can refer to it.
+func (pass *SymbolEnterPass) bindDeclaration(name *tree.Identifier, symbol scope.Symbol) {
+	name.Bind(symbol)
+	if _, ok := pass.declarations[symbol]; !ok {
+		pass.declarations[symbol] = name
+	}
+}
@@ -0,0 +1,145 @@
+package analysis
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/strict-lang/sdk/pkg/compiler/sad"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+// SearchPath lists the directories, that are searched for the api descriptors
+// of imported namespaces. Descriptors are either stored in files, which are
+// named after the qualified name of their namespace, or in the archives of
+// compiled packages, that contain the descriptors of all their namespaces.
+//
+// Namespaces, whose descriptors are not found, are cached by the search path.
+// Looking up a missing descriptor reads every directory and opens every
+// package archive of the search path, it is thus only done once. The cache
+// is kept as long as the search path, which is created once per build.
+type SearchPath struct {
+	Directories []string
+	missing     map[string]bool
+	lock        sync.Mutex
+}
+
+// NewSearchPath creates a search path, that searches the directories in order.
+func NewSearchPath(directories ...string) *SearchPath {
+	return &SearchPath{Directories: directories, missing: map[string]bool{}}
+}
+
+const (
+	descriptorExtension     = ".sad"
+	packageArchiveExtension = ".sar"
+)
+
+// Locate searches the directories in order and decodes the first descriptor
+// of the namespace that is found. A nil search path has no directories.
+func (path *SearchPath) Locate(namespace string) (*sad.Tree, error) {
+	if path == nil || path.isKnownToBeMissing(namespace) {
+		return nil, createMissingDescriptorError(namespace)
+	}
+	fileName := namespace + descriptorExtension
+	for _, directory := range path.Directories {
+		content, ok, err := readDescriptorInDirectory(directory, fileName)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return sad.Decode(content)
+		}
+	}
+	path.markAsMissing(namespace)
+	return nil, createMissingDescriptorError(namespace)
+}
+
+func createMissingDescriptorError(namespace string) error {
+	return fmt.Errorf("no descriptor of namespace %s in the search path", namespace)
+}
+
+func (path *SearchPath) isKnownToBeMissing(namespace string) bool {
+	path.lock.Lock()
+	defer path.lock.Unlock()
+	return path.missing[namespace]
+}
+
+func (path *SearchPath) markAsMissing(namespace string) {
+	path.lock.Lock()
+	defer path.lock.Unlock()
+	if path.missing == nil {
+		path.missing = map[string]bool{}
+	}
+	path.missing[namespace] = true
+}
+
+func readDescriptorInDirectory(directory string, fileName string) (string, bool, error) {
+	content, err := ioutil.ReadFile(filepath.Join(directory, fileName))
+	if err == nil {
+		return string(content), true, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", false, err
+	}
+	archives, err := filepath.Glob(filepath.Join(directory, "*"+packageArchiveExtension))
+	if err != nil {
+		return "", false, err
+	}
+	for _, archive := range archives {
+		if content, ok, err := readDescriptorInArchive(archive, fileName); ok || err != nil {
+			return content, ok, err
+		}
+	}
+	return "", false, nil
+}
+
+func readDescriptorInArchive(archive string, fileName string) (string, bool, error) {
+	reader, err := zip.OpenReader(archive)
+	if err != nil {
+		return "", false, fmt.Errorf("could not open package archive %s: %v", archive, err)
+	}
+	defer reader.Close()
+	for _, file := range reader.File {
+		if file.Name != fileName {
+			continue
+		}
+		content, err := readArchivedFile(file)
+		return content, err == nil, err
+	}
+	return "", false, nil
+}
+
+func readArchivedFile(file *zip.File) (string, error) {
+	opened, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer opened.Close()
+	content, err := ioutil.ReadAll(opened)
+	return string(content), err
+}
+
+// enterNamespace creates the symbol of an imported namespace and enters the
+// classes of its descriptor into the namespaces scope.
+func enterNamespace(name string, descriptor *sad.Tree) *scope.Namespace {
+	id := scope.Id("namespace." + name)
+	namespaceScope := scope.NewOuterScopeWithRootId(id, scope.NewBuiltinScope())
+	sad.Enter(descriptor, namespaceScope)
+	return &scope.Namespace{
+		DeclarationName: extractNamespaceName(name),
+		QualifiedName:   name,
+		Scope:           namespaceScope,
+	}
+}
+
+func extractNamespaceName(qualifiedName string) string {
+	lastDot := strings.LastIndex(qualifiedName, ".")
+	if lastDot == -1 {
+		return qualifiedName
+	}
+	return qualifiedName[lastDot+1:]
+}
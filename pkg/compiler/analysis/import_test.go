@@ -0,0 +1,148 @@
+package analysis
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/syntax"
+	"github.com/strict-lang/sdk/pkg/compiler/sad"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+func createDescriptor() string {
+	return sad.Encode(&sad.Tree{Classes: []*sad.Class{
+		{
+			Name: "Strict.Math.Math",
+			Methods: map[string]sad.Method{
+				"Square": {
+					Name:       "Square",
+					Parameters: []sad.Parameter{{Name: "value", Class: sad.ClassName{Name: "Number"}}},
+					ReturnType: sad.ClassName{Name: "Number"},
+				},
+			},
+			Fields: map[string]sad.Field{},
+		},
+	}})
+}
+
+func createTemporaryDirectory(testing *testing.T) string {
+	directory, err := ioutil.TempDir("", "search-path")
+	if err != nil {
+		testing.Fatal(err)
+	}
+	return directory
+}
+
+func writePackageArchive(testing *testing.T, path string, entries map[string]string) {
+	file, err := os.Create(path)
+	if err != nil {
+		testing.Fatal(err)
+	}
+	defer file.Close()
+	writer := zip.NewWriter(file)
+	for name, content := range entries {
+		entry, err := writer.Create(name)
+		if err != nil {
+			testing.Fatal(err)
+		}
+		if _, err := entry.Write([]byte(content)); err != nil {
+			testing.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		testing.Fatal(err)
+	}
+}
+
+func requireImportedSquareMethod(testing *testing.T, namespace *scope.Namespace) {
+	point := scope.NewReferencePoint("Math")
+	class, ok := scope.LookupClass(namespace.Scope, point)
+	if !ok {
+		testing.Fatal("expected the class Math to be imported")
+	}
+	methods := scope.ListMethods(class.Scope.Lookup(scope.NewReferencePoint("Square")))
+	if len(methods) != 1 || methods[0].ReturnType != scope.Builtins.Number {
+		testing.Error("expected the class Math to have the method Square")
+	}
+}
+
+func TestSearchPath_LocatesDescriptorFiles(testing *testing.T) {
+	directory := createTemporaryDirectory(testing)
+	defer os.RemoveAll(directory)
+	path := filepath.Join(directory, "Strict.Math.sad")
+	if err := ioutil.WriteFile(path, []byte(createDescriptor()), os.ModePerm); err != nil {
+		testing.Fatal(err)
+	}
+	searchPath := NewSearchPath(filepath.Join(directory, "missing"), directory)
+	descriptor, err := searchPath.Locate("Strict.Math")
+	if err != nil {
+		testing.Fatal(err)
+	}
+	namespace := enterNamespace("Strict.Math", descriptor)
+	if namespace.Name() != "Math" {
+		testing.Errorf("unexpected namespace name %s", namespace.Name())
+	}
+	requireImportedSquareMethod(testing, namespace)
+}
+
+func TestSearchPath_LocatesDescriptorsInArchives(testing *testing.T) {
+	directory := createTemporaryDirectory(testing)
+	defer os.RemoveAll(directory)
+	writePackageArchive(testing, filepath.Join(directory, "math.sar"), map[string]string{
+		"Strict.Math.sad": createDescriptor(),
+	})
+	creation := &Creation{SearchPath: NewSearchPath(directory)}
+	namespace, ok := creation.resolveNamespace("Strict.Math")
+	if !ok {
+		testing.Fatal("expected the namespace to be imported from the archive")
+	}
+	requireImportedSquareMethod(testing, namespace)
+	if cached, _ := scope.GlobalNamespaceTable().Lookup("Strict.Math"); cached != namespace {
+		testing.Error("expected the imported namespace to be cached")
+	}
+	if _, err := NewSearchPath(directory).Locate("Strict.Missing"); err == nil {
+		testing.Error("expected namespaces without descriptor to fail")
+	}
+}
+
+func TestSearchPath_CachesMissingDescriptors(testing *testing.T) {
+	directory := createTemporaryDirectory(testing)
+	defer os.RemoveAll(directory)
+	searchPath := NewSearchPath(directory)
+	if _, err := searchPath.Locate("Strict.Late"); err == nil {
+		testing.Fatal("expected namespaces without descriptor to fail")
+	}
+	writePackageArchive(testing, filepath.Join(directory, "late.sar"), map[string]string{
+		"Strict.Late.sad": createDescriptor(),
+	})
+	if _, err := searchPath.Locate("Strict.Late"); err == nil {
+		testing.Error("expected the missing descriptor to be cached")
+	}
+	if _, err := NewSearchPath(directory).Locate("Strict.Late"); err != nil {
+		testing.Errorf("expected the cache to be separate per search path: %v", err)
+	}
+}
+
+func TestCreation_ListsUnresolvedImports(testing *testing.T) {
+	result := syntax.ParseString("Test", "import Strict.Unknown\n")
+	if result.Error != nil {
+		testing.Fatalf("failed to parse Unit: %v", result.Error)
+	}
+	creation := &Creation{
+		Unit: result.TranslationUnit,
+		NamespaceSymbol: &scope.Namespace{
+			DeclarationName: "Test",
+			QualifiedName:   "Test",
+			Scope:           scope.NewOuterScope("namespace.Test", scope.NewBuiltinScope()),
+		},
+		SearchPath: NewSearchPath(createTemporaryDirectory(testing)),
+	}
+	defer os.RemoveAll(creation.SearchPath.Directories[0])
+	unresolved := creation.Create().UnresolvedImports
+	if len(unresolved) != 1 || unresolved[0] != result.TranslationUnit.Imports[0] {
+		testing.Errorf("expected the import to be unresolved, got %v", unresolved)
+	}
+}
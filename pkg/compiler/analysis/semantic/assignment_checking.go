@@ -0,0 +1,276 @@
+package semantic
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+const (
+	CodeUnassignedVariable  diagnostic.Code = "S0701"
+	CodeReassignedBinding   diagnostic.Code = "S0702"
+	CodeReassignedParameter diagnostic.Code = "S0703"
+)
+
+const AssignmentCheckingPassId = "AssignmentCheckingPass"
+
+func init() {
+	passes.Register(&AssignmentCheckingPass{})
+}
+
+// AssignmentCheckingPass ensures that variables, which are declared without
+// a value, are assigned on every path before they are used. It also ensures
+// that names bound by let and parameters are never reassigned.
+type AssignmentCheckingPass struct {
+	context *passes.Context
+	// declared contains the variables of the current method, that are
+	// declared without being assigned.
+	declared map[*scope.Field]bool
+}
+
+// assignmentState is the set of variables, that are definitely assigned at
+// a point of the method. States of unreachable points contain every variable,
+// since no path, that leaves them, reaches the following statements.
+type assignmentState struct {
+	assigned    map[*scope.Field]bool
+	unreachable bool
+}
+
+func newAssignmentState() *assignmentState {
+	return &assignmentState{assigned: map[*scope.Field]bool{}}
+}
+
+func (state *assignmentState) copy() *assignmentState {
+	copied := newAssignmentState()
+	copied.unreachable = state.unreachable
+	for field := range state.assigned {
+		copied.assigned[field] = true
+	}
+	return copied
+}
+
+// mergeStates merges the states at the end of alternative paths. Variables are
+// only definitely assigned after the paths, if every reachable path assigns
+// them.
+func mergeStates(states ...*assignmentState) *assignmentState {
+	var merged *assignmentState
+	for _, state := range states {
+		if state.unreachable {
+			continue
+		}
+		if merged == nil {
+			merged = state.copy()
+			continue
+		}
+		for field := range merged.assigned {
+			if !state.assigned[field] {
+				delete(merged.assigned, field)
+			}
+		}
+	}
+	if merged == nil {
+		merged = newAssignmentState()
+		merged.unreachable = true
+	}
+	return merged
+}
+
+func (pass *AssignmentCheckingPass) Run(context *passes.Context) {
+	pass.context = context
+	context.Unit.AcceptRecursive(pass.createVisitor())
+}
+
+func (pass *AssignmentCheckingPass) Dependencies(isolate *isolate.Isolate) passes.Set {
+	return passes.ListInIsolate(isolate, NameResolutionPassId)
+}
+
+func (pass *AssignmentCheckingPass) Id() passes.Id {
+	return AssignmentCheckingPassId
+}
+
+func (pass *AssignmentCheckingPass) createVisitor() tree.Visitor {
+	visitor := tree.NewEmptyVisitor()
+	visitor.MethodDeclarationVisitor = pass.checkMethod
+	visitor.AssignStatementVisitor = pass.checkReassignment
+	visitor.PostfixExpressionVisitor = pass.checkPostfixReassignment
+	return visitor
+}
+
+func (pass *AssignmentCheckingPass) checkReassignment(assign *tree.AssignStatement) {
+	if target, ok := assign.Target.(*tree.Identifier); ok {
+		pass.checkMutability(target, assign)
+	}
+}
+
+func (pass *AssignmentCheckingPass) checkPostfixReassignment(
+	postfix *tree.PostfixExpression) {
+
+	if target, ok := postfix.Operand.(*tree.Identifier); ok {
+		pass.checkMutability(target, postfix)
+	}
+}
+
+func (pass *AssignmentCheckingPass) checkMutability(
+	target *tree.Identifier, assignment tree.Node) {
+
+	field, ok := scope.AsFieldSymbol(target.Binding())
+	if !ok {
+		return
+	}
+	if field.Immutable {
+		pass.reportInvalidNode(assignment, CodeReassignedBinding, field.Name())
+	} else if field.Kind == scope.ParameterField {
+		pass.reportInvalidNode(assignment, CodeReassignedParameter, field.Name())
+	}
+}
+
+func (pass *AssignmentCheckingPass) checkMethod(method *tree.MethodDeclaration) {
+	if body, ok := method.Body.(*tree.StatementBlock); ok {
+		pass.declared = map[*scope.Field]bool{}
+		pass.analyzeBlock(body, newAssignmentState())
+	}
+}
+
+func (pass *AssignmentCheckingPass) analyzeBlock(
+	block *tree.StatementBlock, state *assignmentState) *assignmentState {
+
+	for _, statement := range block.Children {
+		state = pass.analyzeStatement(statement, state)
+	}
+	return state
+}
+
+func (pass *AssignmentCheckingPass) analyzeStatement(
+	statement tree.Statement, state *assignmentState) *assignmentState {
+
+	switch statement := statement.(type) {
+	case *tree.FieldDeclaration:
+		pass.declareVariable(statement)
+	case *tree.AssignStatement:
+		pass.analyzeAssignment(statement, state)
+	case *tree.ReturnStatement, *tree.BreakStatement:
+		pass.checkUses(statement, state)
+		state.unreachable = true
+	case *tree.StatementBlock:
+		return pass.analyzeBlock(statement, state)
+	case *tree.ConditionalStatement:
+		return pass.analyzeConditional(statement, state)
+	case *tree.MatchStatement:
+		return pass.analyzeMatch(statement, state)
+	case *tree.ForEachLoopStatement:
+		pass.checkUses(statement.Sequence, state)
+		pass.analyzeBlock(statement.Body, state.copy())
+	case *tree.RangedLoopStatement:
+		pass.checkUses(statement.Begin, state)
+		pass.checkUses(statement.End, state)
+		pass.analyzeBlock(statement.Body, state.copy())
+	case *tree.TestStatement:
+		pass.analyzeBlock(statement.Body, state.copy())
+	default:
+		pass.checkUses(statement, state)
+	}
+	return state
+}
+
+func (pass *AssignmentCheckingPass) declareVariable(declaration *tree.FieldDeclaration) {
+	if field, ok := scope.AsFieldSymbol(declaration.Name.Binding()); ok {
+		pass.declared[field] = true
+	}
+}
+
+// analyzeAssignment checks the uses of the assigned value before marking the
+// target as assigned. Operations like add-assign also use their target.
+func (pass *AssignmentCheckingPass) analyzeAssignment(
+	assign *tree.AssignStatement, state *assignmentState) {
+
+	pass.checkUses(assign.Value, state)
+	if _, isOperation := assignOperations[assign.Operator]; isOperation {
+		pass.checkUses(assign.Target, state)
+	}
+	switch target := assign.Target.(type) {
+	case *tree.FieldDeclaration:
+		pass.markAsAssigned(target.Name, state)
+	case *tree.Identifier:
+		pass.markAsAssigned(target, state)
+	default:
+		pass.checkUses(assign.Target, state)
+	}
+}
+
+func (pass *AssignmentCheckingPass) markAsAssigned(
+	name *tree.Identifier, state *assignmentState) {
+
+	if field, ok := scope.AsFieldSymbol(name.Binding()); ok {
+		state.assigned[field] = true
+	}
+}
+
+func (pass *AssignmentCheckingPass) analyzeConditional(
+	conditional *tree.ConditionalStatement,
+	state *assignmentState) *assignmentState {
+
+	pass.checkUses(conditional.Condition, state)
+	consequence := pass.analyzeBlock(conditional.Consequence, state.copy())
+	if conditional.Alternative == nil {
+		return mergeStates(consequence, state)
+	}
+	alternative := pass.analyzeBlock(conditional.Alternative, state.copy())
+	return mergeStates(consequence, alternative)
+}
+
+// analyzeMatch analyses every arm of the match. If the match is not exhaustive,
+// the state before the match is also merged, since no arm may be executed.
+func (pass *AssignmentCheckingPass) analyzeMatch(
+	match *tree.MatchStatement, state *assignmentState) *assignmentState {
+
+	pass.checkUses(match.Subject, state)
+	var states []*assignmentState
+	for _, arm := range match.Arms {
+		states = append(states, pass.analyzeBlock(arm.Body, state.copy()))
+	}
+	if !isExhaustiveMatch(match) {
+		states = append(states, state)
+	}
+	return mergeStates(states...)
+}
+
+// checkUses reports every variable, that is used by the node but not yet
+// assigned. Variables are only reported once, the first time they are used.
+func (pass *AssignmentCheckingPass) checkUses(node tree.Node, state *assignmentState) {
+	if state.unreachable {
+		return
+	}
+	visitor := tree.NewEmptyVisitor()
+	visitor.IdentifierVisitor = func(identifier *tree.Identifier) {
+		pass.checkUse(identifier, state)
+	}
+	node.AcceptRecursive(visitor)
+}
+
+func (pass *AssignmentCheckingPass) checkUse(
+	identifier *tree.Identifier, state *assignmentState) {
+
+	if identifier.IsPartOfDeclaration() {
+		return
+	}
+	field, ok := scope.AsFieldSymbol(identifier.Binding())
+	if ok && pass.declared[field] && !state.assigned[field] {
+		pass.reportInvalidNode(identifier, CodeUnassignedVariable, field.Name())
+		state.assigned[field] = true
+	}
+}
+
+func (pass *AssignmentCheckingPass) reportInvalidNode(
+	node tree.Node, code diagnostic.Code, arguments ...interface{}) {
+
+	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+		Kind:     &diagnostic.Error,
+		Stage:    &diagnostic.SemanticAnalysis,
+		Message:  diagnostic.FormatMessage(code, arguments...),
+		Code:     code,
+		UnitName: pass.context.Unit.Name,
+		Position: node.Locate(),
+	})
+}
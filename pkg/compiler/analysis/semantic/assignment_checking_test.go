@@ -0,0 +1,59 @@
+package semantic
+
+import "testing"
+
+func TestAssignmentCheckingPass_AcceptsValidCode(testing *testing.T) {
+	entries := runPass(testing, AssignmentCheckingPassId, `
+method sign(value Number) returns Number
+  has result Number
+  if value < 0
+    result = 0 - 1
+  else
+    result = 1
+  return result
+
+method count(numbers Number[]) returns Number
+  has total Number
+  total = 0
+  for number in numbers
+    total += 1
+  let doubled = total * 2
+  return doubled
+`)
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
+
+func TestAssignmentCheckingPass_ReportsInvalidCode(testing *testing.T) {
+	entries := runPass(testing, AssignmentCheckingPassId, `
+method sign(value Number) returns Number
+  has result Number
+  if value < 0
+    result = 0 - 1
+  return result
+
+method count(numbers Number[]) returns Number
+  has total Number
+  total += 1
+  let doubled = total * 2
+  doubled = 0
+  numbers = []
+  return doubled
+`)
+	expectedMessages := []string{
+		"The variable result is used before it is assigned",
+		"The variable total is used before it is assigned",
+		"The name doubled is bound by let and can not be reassigned",
+		"The parameter numbers can not be reassigned",
+	}
+	if len(entries) != len(expectedMessages) {
+		testing.Errorf("expected %d diagnostics but got %d",
+			len(expectedMessages), len(entries))
+	}
+	for _, expected := range expectedMessages {
+		if !containsMessage(entries, expected) {
+			testing.Errorf("expected diagnostic %q to be reported", expected)
+		}
+	}
+}
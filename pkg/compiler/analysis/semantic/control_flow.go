@@ -0,0 +1,215 @@
+package semantic
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+const (
+	CodeMissingReturn          diagnostic.Code = "S0301"
+	CodeUnreachableCode        diagnostic.Code = "S0302"
+	CodeReturnInYieldingMethod diagnostic.Code = "S0303"
+)
+
+const ControlFlowPassId = "ControlFlowPass"
+
+func init() {
+	passes.Register(&ControlFlowPass{})
+}
+
+// ControlFlowPass analyses the flow of control through the bodies of
+// methods. It reports methods that can complete without returning a value,
+// statements that can never be executed and methods that both yield and
+// return values.
+type ControlFlowPass struct {
+	context *passes.Context
+}
+
+// completion describes how the execution of a statement ends. Statements
+// either complete normally and continue with the next statement, or they
+// leave the surrounding block by breaking or returning.
+type completion int
+
+const (
+	completesNormally completion = iota
+	breaks
+	returns
+)
+
+func (pass *ControlFlowPass) Run(context *passes.Context) {
+	pass.context = context
+	visitor := tree.NewEmptyVisitor()
+	visitor.MethodDeclarationVisitor = pass.checkMethod
+	context.Unit.AcceptRecursive(visitor)
+}
+
+// The pass depends on the name resolution, to know whether matches over
+// unions cover every case.
+func (pass *ControlFlowPass) Dependencies(isolate *isolate.Isolate) passes.Set {
+	return passes.ListInIsolate(isolate, NameResolutionPassId)
+}
+
+func (pass *ControlFlowPass) Id() passes.Id {
+	return ControlFlowPassId
+}
+
+func (pass *ControlFlowPass) checkMethod(method *tree.MethodDeclaration) {
+	body, ok := method.Body.(*tree.StatementBlock)
+	if !ok || method.Abstract {
+		return
+	}
+	bodyCompletion := pass.analyzeBlock(body)
+	if pass.checkYields(method, body) {
+		return
+	}
+	if !isVoidMethod(method) && bodyCompletion != returns {
+		pass.reportInvalidNode(method.Name, CodeMissingReturn, method.Name.Value)
+	}
+}
+
+func isVoidMethod(method *tree.MethodDeclaration) bool {
+	return method.Type == nil || method.Type.BaseName() == scope.Builtins.Void.Name()
+}
+
+// checkYields reports every return of a value inside of a method that yields
+// values. It returns true if the method yields values, since those methods
+// implicitly return the list of their yielded values.
+func (pass *ControlFlowPass) checkYields(
+	method *tree.MethodDeclaration, body *tree.StatementBlock) bool {
+
+	var yields bool
+	var valueReturns []*tree.ReturnStatement
+	visitor := tree.NewEmptyVisitor()
+	visitor.YieldStatementVisitor = func(*tree.YieldStatement) {
+		yields = true
+	}
+	visitor.ReturnStatementVisitor = func(statement *tree.ReturnStatement) {
+		if statement.Value != nil {
+			valueReturns = append(valueReturns, statement)
+		}
+	}
+	body.AcceptRecursive(visitor)
+	if yields {
+		for _, statement := range valueReturns {
+			pass.reportInvalidNode(statement, CodeReturnInYieldingMethod,
+				method.Name.Value)
+		}
+	}
+	return yields
+}
+
+// analyzeBlock analyses the statements of the block in order. Statements that
+// follow a statement, which leaves the block, are unreachable. Only the first
+// of them is reported, to not report every statement of a dead region.
+func (pass *ControlFlowPass) analyzeBlock(block *tree.StatementBlock) completion {
+	for index, statement := range block.Children {
+		if result := pass.analyzeStatement(statement); result != completesNormally {
+			if index != len(block.Children)-1 {
+				pass.reportInvalidNode(block.Children[index+1], CodeUnreachableCode)
+			}
+			return result
+		}
+	}
+	return completesNormally
+}
+
+func (pass *ControlFlowPass) analyzeStatement(statement tree.Statement) completion {
+	switch statement := statement.(type) {
+	case *tree.ReturnStatement:
+		return returns
+	case *tree.BreakStatement:
+		return breaks
+	case *tree.StatementBlock:
+		return pass.analyzeBlock(statement)
+	case *tree.ConditionalStatement:
+		return pass.analyzeConditional(statement)
+	case *tree.MatchStatement:
+		return pass.analyzeMatch(statement)
+	case *tree.ForEachLoopStatement:
+		return pass.analyzeLoopBody(statement.Body)
+	case *tree.RangedLoopStatement:
+		return pass.analyzeLoopBody(statement.Body)
+	case *tree.TestStatement:
+		pass.analyzeBlock(statement.Body)
+	}
+	return completesNormally
+}
+
+// analyzeLoopBody analyses the body of a loop. Loops always complete normally,
+// since their body may not be executed at all and breaks only leave the loop.
+func (pass *ControlFlowPass) analyzeLoopBody(body *tree.StatementBlock) completion {
+	pass.analyzeBlock(body)
+	return completesNormally
+}
+
+// analyzeConditional analyses both branches of the conditional. The
+// conditional only leaves the block if it has an alternative and neither
+// branch completes normally.
+func (pass *ControlFlowPass) analyzeConditional(
+	conditional *tree.ConditionalStatement) completion {
+
+	consequence := pass.analyzeBlock(conditional.Consequence)
+	if conditional.Alternative == nil {
+		return completesNormally
+	}
+	alternative := pass.analyzeBlock(conditional.Alternative)
+	return combineBranches(consequence, alternative)
+}
+
+// analyzeMatch analyses every arm of the match. Like a conditional, the match
+// only leaves the block if no arm completes normally and it is exhaustive.
+func (pass *ControlFlowPass) analyzeMatch(match *tree.MatchStatement) completion {
+	result := returns
+	for _, arm := range match.Arms {
+		result = combineBranches(result, pass.analyzeBlock(arm.Body))
+	}
+	if !isExhaustiveMatch(match) {
+		return completesNormally
+	}
+	return result
+}
+
+// combineBranches returns the completion of a statement, that executes one of
+// two branches. It is the weaker completion of both branches.
+func combineBranches(first completion, second completion) completion {
+	if first < second {
+		return first
+	}
+	return second
+}
+
+func isExhaustiveMatch(match *tree.MatchStatement) bool {
+	if match.HasDefaultArm() {
+		return true
+	}
+	union, ok := match.Subject.ResolvedType()
+	if !ok || !scope.IsUnionClass(union) {
+		return false
+	}
+	matched := map[string]bool{}
+	for _, arm := range match.Arms {
+		matched[arm.Case.Value] = true
+	}
+	for _, name := range scope.UnionCaseNames(union) {
+		if !matched[name] {
+			return false
+		}
+	}
+	return true
+}
+
+func (pass *ControlFlowPass) reportInvalidNode(
+	node tree.Node, code diagnostic.Code, arguments ...interface{}) {
+
+	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+		Kind:     &diagnostic.Error,
+		Stage:    &diagnostic.SemanticAnalysis,
+		Message:  diagnostic.FormatMessage(code, arguments...),
+		Code:     code,
+		UnitName: pass.context.Unit.Name,
+		Position: node.Locate(),
+	})
+}
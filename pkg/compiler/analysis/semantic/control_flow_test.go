@@ -0,0 +1,65 @@
+package semantic
+
+import "testing"
+
+func TestControlFlowPass_AcceptsValidCode(testing *testing.T) {
+	entries := runPass(testing, ControlFlowPassId, `
+method sign(value Number) returns Number
+  if value < 0
+    return 0 - 1
+  else
+    return 1
+
+method first(numbers Number[]) returns Number
+  for number in numbers
+    return number
+  return 0
+
+method doubled(numbers Number[]) returns Number[]
+  for number in numbers
+    yield number
+
+method log(value Number)
+  if value < 0
+    return
+  value = 0
+`)
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
+
+func TestControlFlowPass_ReportsInvalidCode(testing *testing.T) {
+	entries := runPass(testing, ControlFlowPassId, `
+method sign(value Number) returns Number
+  if value < 0
+    return 0 - 1
+
+method first(numbers Number[]) returns Number
+  for number in numbers
+    return number
+    number = 0
+  return 0
+  numbers = []
+
+method doubled(numbers Number[]) returns Number[]
+  for number in numbers
+    yield number
+  return []
+`)
+	expectedMessages := []string{
+		"The method sign does not return a value on every path",
+		"The statement is never executed",
+		"The statement is never executed",
+		"The method doubled yields its values and can not also return a value",
+	}
+	if len(entries) != len(expectedMessages) {
+		testing.Errorf("expected %d diagnostics but got %d",
+			len(expectedMessages), len(entries))
+	}
+	for _, expected := range expectedMessages {
+		if !containsMessage(entries, expected) {
+			testing.Errorf("expected diagnostic %q to be reported", expected)
+		}
+	}
+}
@@ -0,0 +1,1050 @@
+package semantic
+
+import "github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+
+func init() {
+	diagnostic.RegisterExplanations(nameResolutionExplanations...)
+	diagnostic.RegisterExplanations(typeCheckingExplanations...)
+	diagnostic.RegisterExplanations(controlFlowExplanations...)
+	diagnostic.RegisterExplanations(resultHandlingExplanations...)
+	diagnostic.RegisterExplanations(unionMatchingExplanations...)
+	diagnostic.RegisterExplanations(traitConformanceExplanations...)
+	diagnostic.RegisterExplanations(assignmentCheckingExplanations...)
+	diagnostic.RegisterExplanations(unusedSymbolExplanations...)
+	diagnostic.RegisterExplanations(namingExplanations...)
+}
+
+var nameResolutionExplanations = []*diagnostic.Explanation{
+	{
+		Code:  CodeUnresolvedIdentifier,
+		Title: "Unresolved identifier",
+		Description: `
+The identifier does not refer to any field, variable, parameter or class, that
+is visible at its position. Names are only visible after they are declared and
+inside of the block, that declares them. If a similar name is visible, it is
+suggested as a fix.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method total(numbers Number[]) returns Number
+  has totalCount Number
+  totalCount = 0
+  for number in numbers
+    totalCount = totalCount + number
+  return totalCuont`,
+				Corrected: `
+method total(numbers Number[]) returns Number
+  has totalCount Number
+  totalCount = 0
+  for number in numbers
+    totalCount = totalCount + number
+  return totalCount`,
+			},
+		},
+	},
+	{
+		Code:  CodeUnresolvedCall,
+		Title: "Unresolved call",
+		Description: `
+The called method is neither declared in the unit, nor imported, nor is it a
+method of the value, on which it is called. Methods of other namespaces have
+to be imported before they can be called.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method calculateTotal(numbers Number[]) returns Number
+  has total Number
+  total = 0
+  for number in numbers
+    total = total + number
+  return total
+
+method average(numbers Number[]) returns Number
+  return calculateTotl(numbers) / numbers.Length()`,
+				Corrected: `
+method calculateTotal(numbers Number[]) returns Number
+  has total Number
+  total = 0
+  for number in numbers
+    total = total + number
+  return total
+
+method average(numbers Number[]) returns Number
+  return calculateTotal(numbers) / numbers.Length()`,
+			},
+		},
+	},
+	{
+		Code:  CodeAmbiguousCall,
+		Title: "Ambiguous call",
+		Description: `
+More than one overload of the method accepts the passed arguments, and none
+of them is more specific than the others. Labeling the arguments or passing
+values of a more specific type selects a single overload.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method pick(left Number) returns Number
+  return left
+
+method pick(right Number) returns Number
+  return right
+
+method run() returns Number
+  return pick(1)`,
+				Corrected: `
+method pick(left Number) returns Number
+  return left
+
+method pick(right Number) returns Number
+  return right
+
+method run() returns Number
+  return pick(left = 1)`,
+			},
+		},
+	},
+	{
+		Code:  CodeNoMatchingOverload,
+		Title: "No matching overload",
+		Description: `
+The method is declared, but none of its overloads accepts the passed
+arguments. The number, labels and types of the arguments have to match the
+parameters of one of the overloads.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method scale(value Number) returns Number
+  return value * 2
+
+method scale(factor Float) returns Float
+  return factor * 2
+
+method run() returns Number
+  return scale(ratio = 2)`,
+				Corrected: `
+method scale(value Number) returns Number
+  return value * 2
+
+method scale(factor Float) returns Float
+  return factor * 2
+
+method run() returns Number
+  return scale(value = 2)`,
+			},
+		},
+	},
+	{
+		Code:  CodeFailedInference,
+		Title: "Type can not be inferred",
+		Description: `
+The type of the expression can not be inferred, because one of its operands
+could not be resolved. This error is usually reported together with an error
+at the operand. Fixing the other error resolves this one as well.`,
+	},
+	{
+		Code:  CodeUnresolvedImport,
+		Title: "Unresolved import",
+		Description: `
+The imported namespace is neither a namespace of the compiled package, nor is
+its descriptor found on the search path. Namespaces of other packages are
+imported from their descriptors, which are looked up in the import paths of
+the build configuration and in the package archives, that they contain.`,
+	},
+}
+
+var typeCheckingExplanations = []*diagnostic.Explanation{
+	{
+		Code:  CodeInvalidOperands,
+		Title: "Invalid operands",
+		Description: `
+The binary operator is not defined for the types of its operands. Arithmetic
+operators can only be applied to numbers, logical operators only to booleans.
+Values of different types have to be converted explicitly.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method isLarge(count Number) returns Boolean
+  return count && 100`,
+				Corrected: `
+method isLarge(count Number) returns Boolean
+  return count > 100`,
+			},
+		},
+	},
+	{
+		Code:  CodeInvalidOperand,
+		Title: "Invalid operand",
+		Description: `
+The unary operator is not defined for the type of its operand. Negation can
+only be applied to booleans.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method isEmpty(count Number) returns Boolean
+  return !count`,
+				Corrected: `
+method isEmpty(count Number) returns Boolean
+  return count == 0`,
+			},
+		},
+	},
+	{
+		Code:  CodeInvalidAssign,
+		Title: "Invalid assignment",
+		Description: `
+The assigned value is not of the type of the field or variable, that it is
+assigned to. A value can only be assigned if its type is the type of the
+target or a subtype of it.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+has count Number
+
+method reset()
+  count = "zero"`,
+				Corrected: `
+has count Number
+
+method reset()
+  count = 0`,
+			},
+		},
+	},
+	{
+		Code:  CodeArgumentCount,
+		Title: "Wrong number of arguments",
+		Description: `
+The method is called with more or fewer arguments, than it has parameters.
+Every parameter has to be passed exactly one argument.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method add(left Number, right Number) returns Number
+  return left + right
+
+method run()
+  add(1)`,
+				Corrected: `
+method add(left Number, right Number) returns Number
+  return left + right
+
+method run()
+  add(1, 2)`,
+			},
+		},
+	},
+	{
+		Code:  CodeUnknownLabel,
+		Title: "Unknown argument label",
+		Description: `
+The argument is labeled with a name, that is not the name of any parameter of
+the called method. Labels have to match the names of the parameters.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method divide(left Number, right Number) returns Number
+  return left / right
+
+method run() returns Number
+  return divide(left = 4, divisor = 2)`,
+				Corrected: `
+method divide(left Number, right Number) returns Number
+  return left / right
+
+method run() returns Number
+  return divide(left = 4, right = 2)`,
+			},
+		},
+	},
+	{
+		Code:  CodeInvalidArgument,
+		Title: "Invalid argument",
+		Description: `
+The argument is not of the type of the parameter, that it is passed for. An
+argument can only be passed if its type is the type of the parameter or a
+subtype of it.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method greet(name String) returns String
+  return "Hello " + name
+
+method run() returns String
+  return greet(42)`,
+				Corrected: `
+method greet(name String) returns String
+  return "Hello " + name
+
+method run() returns String
+  return greet("Strict")`,
+			},
+		},
+	},
+	{
+		Code:  CodeNonBooleanCondition,
+		Title: "Condition is not a Boolean",
+		Description: `
+Conditions of if statements and loops have to evaluate to a Boolean. Other
+values are never converted to a Boolean implicitly.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method describe(count Number) returns String
+  if count
+    return "some"
+  return "none"`,
+				Corrected: `
+method describe(count Number) returns String
+  if count > 0
+    return "some"
+  return "none"`,
+			},
+		},
+	},
+	{
+		Code:  CodeInvalidReturn,
+		Title: "Invalid return value",
+		Description: `
+The returned value is not of the return type of the method. A value can only
+be returned if its type is the return type or a subtype of it.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method half(value Number) returns Number
+  return "half"`,
+				Corrected: `
+method half(value Number) returns Number
+  return value / 2`,
+			},
+		},
+	},
+	{
+		Code:  CodeUnsatisfiedBound,
+		Title: "Unsatisfied generic bound",
+		Description: `
+The class, that is passed as an argument of a generic class, does not
+implement the trait, which bounds the type parameter. Only classes, that
+implement the bound, can be passed for the parameter.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+// Registry.strict
+let T = generic(App)
+
+// Main.strict
+method count(registry Registry<Number>) returns Number
+  return registry.Length()`,
+				Corrected: `
+// Main.strict
+method count(registry Registry<Game>) returns Number
+  return registry.Length()`,
+			},
+		},
+	},
+	{
+		Code:  CodeYieldOutsideOfMethod,
+		Title: "Yield outside of a method",
+		Description: `
+Values can only be yielded from the body of a method. The yielded values form
+the list, that is returned by the method.`,
+	},
+	{
+		Code:  CodeYieldingNonList,
+		Title: "Yielding method does not return a list",
+		Description: `
+Methods, that yield values, implicitly return the list of the yielded values.
+Their return type thus has to be a list.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method positives(numbers Number[]) returns Number
+  for number in numbers
+    if number > 0
+      yield number`,
+				Corrected: `
+method positives(numbers Number[]) returns Number[]
+  for number in numbers
+    if number > 0
+      yield number`,
+			},
+		},
+	},
+	{
+		Code:  CodeInvalidYield,
+		Title: "Invalid yielded value",
+		Description: `
+The yielded value is not of the element type of the list, that is returned by
+the method.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method signs(numbers Number[]) returns Number[]
+  for number in numbers
+    yield number > 0`,
+				Corrected: `
+method signs(numbers Number[]) returns Boolean[]
+  for number in numbers
+    yield number > 0`,
+			},
+		},
+	},
+	{
+		Code:  CodeDuplicateArgument,
+		Title: "Duplicate argument",
+		Description: `
+More than one argument is passed to the same parameter. This happens, if two
+arguments have the same label or if a labeled argument is passed to the
+parameter at the position of an unlabeled one.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method divide(left Number, right Number) returns Number
+  return left / right
+
+method run() returns Number
+  return divide(left = 4, left = 2)`,
+				Corrected: `
+method divide(left Number, right Number) returns Number
+  return left / right
+
+method run() returns Number
+  return divide(left = 4, right = 2)`,
+			},
+		},
+	},
+}
+
+var controlFlowExplanations = []*diagnostic.Explanation{
+	{
+		Code:  CodeMissingReturn,
+		Title: "Missing return",
+		Description: `
+The method returns a value, but there is a path through its body, that ends
+without returning one. Every path has to end with a return statement.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method sign(value Number) returns Number
+  if value < 0
+    return 0 - 1`,
+				Corrected: `
+method sign(value Number) returns Number
+  if value < 0
+    return 0 - 1
+  return 1`,
+			},
+		},
+	},
+	{
+		Code:  CodeUnreachableCode,
+		Title: "Unreachable code",
+		Description: `
+The statement follows a statement, that always leaves the block, like a
+return statement. It can thus never be executed. Only the first unreachable
+statement of a block is reported.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method first(numbers Number[]) returns Number
+  for number in numbers
+    return number
+    number = 0
+  return 0`,
+				Corrected: `
+method first(numbers Number[]) returns Number
+  for number in numbers
+    return number
+  return 0`,
+			},
+		},
+	},
+	{
+		Code:  CodeReturnInYieldingMethod,
+		Title: "Return of a value in a yielding method",
+		Description: `
+Methods, that yield values, implicitly return the list of their yielded
+values. They can thus not also return a value. A return statement without a
+value can still be used to stop yielding.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method doubled(numbers Number[]) returns Number[]
+  for number in numbers
+    yield number * 2
+  return []`,
+				Corrected: `
+method doubled(numbers Number[]) returns Number[]
+  for number in numbers
+    yield number * 2`,
+			},
+		},
+	},
+}
+
+var resultHandlingExplanations = []*diagnostic.Explanation{
+	{
+		Code:  CodeIgnoredResult,
+		Title: "Ignored Result",
+		Description: `
+The expression evaluates to a Result, which may hold a failure, but the
+Result is neither handled nor propagated. Failures must never be dropped
+silently. Either store the Result and check it with IsError(), or propagate
+its failure to the caller with the '?' operator.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method write(text String) returns Result<Number>
+  return text.Length()
+
+method save(text String) returns Result<Number>
+  write(text)
+  return text.Length()`,
+				Corrected: `
+method write(text String) returns Result<Number>
+  return text.Length()
+
+method save(text String) returns Result<Number>
+  write(text)?
+  return text.Length()`,
+			},
+		},
+	},
+	{
+		Code:  CodePropagationOutsideOfResultMethod,
+		Title: "Propagation outside of a Result method",
+		Description: `
+The '?' operator returns the failure of a Result from the enclosing method.
+It can thus only be used in methods, that return a Result themselves.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method read(path String) returns Result<String>
+  return path
+
+method load(path String) returns String
+  return read(path)?`,
+				Corrected: `
+method read(path String) returns Result<String>
+  return path
+
+method load(path String) returns Result<String>
+  return read(path)?`,
+			},
+		},
+	},
+	{
+		Code:  CodePropagationOfNonResult,
+		Title: "Propagation of a value, that is not a Result",
+		Description: `
+The '?' operator can only be applied to expressions, that evaluate to a
+Result. Other values can not fail and need no propagation.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method length(text String) returns Result<Number>
+  return text.Length()?`,
+				Corrected: `
+method length(text String) returns Result<Number>
+  return text.Length()`,
+			},
+		},
+	},
+	{
+		Code:  CodeErrorOutsideOfReturn,
+		Title: "Error outside of a return",
+		Description: `
+Failures are created by calling Error. The created failure has to be returned
+immediately from a method, that returns a Result.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method parse(text String) returns Number
+  if text.Length() == 0
+    return Error("text is empty")
+  return 0`,
+				Corrected: `
+method parse(text String) returns Result<Number>
+  if text.Length() == 0
+    return Error("text is empty")
+  return 0`,
+			},
+		},
+	},
+}
+
+var unionMatchingExplanations = []*diagnostic.Explanation{
+	{
+		Code:  CodeMatchOfNonUnion,
+		Title: "Match of a value, that is not a union",
+		Description: `
+A match inspects the case of a union value. The matched value thus has to be
+of a union type. Other values are inspected with if statements.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method describe(count Number) returns String
+  match count
+    Zero
+      return "none"
+  return "some"`,
+				Corrected: `
+method describe(count Number) returns String
+  if count == 0
+    return "none"
+  return "some"`,
+			},
+		},
+	},
+	{
+		Code:  CodeUnknownUnionCase,
+		Title: "Unknown union case",
+		Description: `
+The arm of the match names a case, that is not declared by the union of the
+matched value.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    Square(side)
+      return side * side
+    else
+      return 0`,
+				Corrected: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    Circle(radius)
+      return radius * radius * 3
+    else
+      return 0`,
+			},
+		},
+	},
+	{
+		Code:  CodeDuplicateMatchArm,
+		Title: "Duplicate match arm",
+		Description: `
+More than one arm of the match matches the same case. Only the first of them
+could ever be executed.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    Circle(radius)
+      return radius
+    Circle(radius)
+      return 0
+    Empty
+      return 0`,
+				Corrected: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    Circle(radius)
+      return radius
+    Empty
+      return 0`,
+			},
+		},
+	},
+	{
+		Code:  CodeMisplacedDefaultArm,
+		Title: "Misplaced else arm",
+		Description: `
+The else arm matches every case, that is not matched by another arm. It has
+to be the last arm of the match, since the arms after it could never be
+executed.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    else
+      return 0
+    Circle(radius)
+      return radius`,
+				Corrected: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    Circle(radius)
+      return radius
+    else
+      return 0`,
+			},
+		},
+	},
+	{
+		Code:  CodeTooManyBindings,
+		Title: "Too many bindings",
+		Description: `
+The arm binds more values, than are carried by the matched case. A case can
+bind at most one name for every value of the case.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    Circle(radius, center)
+      return radius
+    else
+      return 0`,
+				Corrected: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    Circle(radius)
+      return radius
+    else
+      return 0`,
+			},
+		},
+	},
+	{
+		Code:  CodeNonExhaustiveMatch,
+		Title: "Non-exhaustive match",
+		Description: `
+The arms of the match do not cover every case of the union. Every case has to
+be matched by an arm, unless the match has an else arm.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    Circle(radius)
+      return radius`,
+				Corrected: `
+type Shape
+  Circle(radius Number)
+  Empty
+
+method area(shape Shape) returns Number
+  match shape
+    Circle(radius)
+      return radius
+    Empty
+      return 0`,
+			},
+		},
+	},
+}
+
+var traitConformanceExplanations = []*diagnostic.Explanation{
+	{
+		Code:  CodeUnknownTrait,
+		Title: "Unknown trait",
+		Description: `
+The class implements a trait, that does not exist. Traits of other namespaces
+have to be imported before they can be implemented.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+implement Ap`,
+				Corrected: `
+implement App
+
+method run()
+  name(True)
+
+method name(short Boolean) returns String
+  if short
+    return "app"
+  return "application"`,
+			},
+		},
+	},
+	{
+		Code:  CodeMissingTraitMethod,
+		Title: "Missing trait method",
+		Description: `
+The class implements a trait, but does not declare one of the methods, that
+are required by the trait. Every method of the trait has to be implemented.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+implement App
+
+method name(short Boolean) returns String
+  if short
+    return "app"
+  return "application"`,
+				Corrected: `
+implement App
+
+method run()
+  name(True)
+
+method name(short Boolean) returns String
+  if short
+    return "app"
+  return "application"`,
+			},
+		},
+	},
+	{
+		Code:  CodeMismatchedTraitMethod,
+		Title: "Mismatched trait method",
+		Description: `
+The class declares a method of the trait, but its parameters or its return
+type do not match the method of the trait. Implemented methods have to take
+the same parameters and return the same type, or a subtype of it.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+implement App
+
+method run()
+  name(1)
+
+method name(short Number) returns String
+  if short > 0
+    return "app"
+  return "application"`,
+				Corrected: `
+implement App
+
+method run()
+  name(True)
+
+method name(short Boolean) returns String
+  if short
+    return "app"
+  return "application"`,
+			},
+		},
+	},
+}
+
+var assignmentCheckingExplanations = []*diagnostic.Explanation{
+	{
+		Code:  CodeUnassignedVariable,
+		Title: "Use of an unassigned variable",
+		Description: `
+The variable is used on a path, on which it has not been assigned yet. Every
+variable has to be assigned on all paths, before it is read.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method sign(value Number) returns Number
+  has result Number
+  if value < 0
+    result = 0 - 1
+  return result`,
+				Corrected: `
+method sign(value Number) returns Number
+  has result Number
+  if value < 0
+    result = 0 - 1
+  else
+    result = 1
+  return result`,
+			},
+		},
+	},
+	{
+		Code:  CodeReassignedBinding,
+		Title: "Reassignment of a let binding",
+		Description: `
+Names, that are bound by let, are immutable and can not be reassigned.
+Declare a variable with has instead, if its value has to change.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method sum(numbers Number[]) returns Number
+  let total = 0
+  for number in numbers
+    total = total + number
+  return total`,
+				Corrected: `
+method sum(numbers Number[]) returns Number
+  has total Number
+  total = 0
+  for number in numbers
+    total = total + number
+  return total`,
+			},
+		},
+	},
+	{
+		Code:  CodeReassignedParameter,
+		Title: "Reassignment of a parameter",
+		Description: `
+Parameters are immutable and can not be reassigned. Bind the changed value to
+a new name instead.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method clamp(value Number) returns Number
+  if value > 100
+    value = 100
+  return value`,
+				Corrected: `
+method clamp(value Number) returns Number
+  if value > 100
+    return 100
+  return value`,
+			},
+		},
+	},
+}
+
+var unusedSymbolExplanations = []*diagnostic.Explanation{
+	{
+		Code:  CodeUnusedVariable,
+		Title: "Unused variable",
+		Description: `
+The variable is declared, but its value is never used. Unused variables are
+usually left over from a change, or hint at a mistake in the code. The
+variable can be removed with the fix, that is attached to the warning.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method square(value Number) returns Number
+  let doubled = value * 2
+  return value * value`,
+				Corrected: `
+method square(value Number) returns Number
+  return value * value`,
+			},
+		},
+	},
+	{
+		Code:  CodeUnusedField,
+		Title: "Unused field",
+		Description: `
+The private field of the class is declared, but never used by any of its
+methods. Exported fields, whose names start with an upper case letter, are
+not reported, since they may be used by other units.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+has count Number
+has ignored Number
+
+method increment()
+  count = count + 1`,
+				Corrected: `
+has count Number
+
+method increment()
+  count = count + 1`,
+			},
+		},
+	},
+	{
+		Code:  CodeUnusedParameter,
+		Title: "Unused parameter",
+		Description: `
+The parameter is never used in the body of the method. Parameters of abstract
+methods are not reported, since they have no body.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method square(value Number, factor Number) returns Number
+  return value * value`,
+				Corrected: `
+method square(value Number) returns Number
+  return value * value`,
+			},
+		},
+	},
+	{
+		Code:  CodeUnusedImport,
+		Title: "Unused import",
+		Description: `
+The imported module is never referenced by the unit. The import can be
+removed with the fix, that is attached to the warning.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+import Math
+
+method square(value Number) returns Number
+  return value * value`,
+				Corrected: `
+method square(value Number) returns Number
+  return value * value`,
+			},
+		},
+	},
+}
+
+var namingExplanations = []*diagnostic.Explanation{
+	{
+		Code:  CodeInvalidModuleImport,
+		Title: "Invalid name of an imported module",
+		Description: `
+Modules are named in UpperCamelCase, and are imported by that name. Files,
+whose names are not UpperCamelCase, have to be imported with an alias.`,
+	},
+	{
+		Code:  CodeInvalidUnitName,
+		Title: "Invalid unit name",
+		Description: `
+The name of a unit is derived from the name of its file and has to be
+lowerCamelCase. Rename the file to change the name of the unit.`,
+	},
+	{
+		Code:  CodeInvalidDeclarationName,
+		Title: "Invalid declaration name",
+		Description: `
+Fields, variables, parameters and methods have to be named in lowerCamelCase.
+Local declarations can be renamed with the fix, that is attached to the error.
+It renames every reference to the declaration as well.`,
+		Examples: []diagnostic.Example{
+			{
+				Erroneous: `
+method count(numbers Number[]) returns Number
+  let Total_Count = numbers.Length()
+  return Total_Count`,
+				Corrected: `
+method count(numbers Number[]) returns Number
+  let totalCount = numbers.Length()
+  return totalCount`,
+			},
+		},
+	},
+	{
+		Code:  CodeImplicitParameterName,
+		Title: "Implicit parameter name",
+		Description: `
+A parameter may omit its name, if it is named like its type. This is only
+allowed if no other parameter of the method has the same type, otherwise the
+parameters could not be told apart.`,
+	},
+}
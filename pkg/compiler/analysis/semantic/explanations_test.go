@@ -0,0 +1,92 @@
+package semantic
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+	"github.com/strict-lang/sdk/pkg/compiler/typing"
+)
+
+var codePattern = regexp.MustCompile(`^[LPSB][0-9]{4}$`)
+
+func TestExplanations_AreComplete(testing *testing.T) {
+	for _, explanation := range diagnostic.ListExplanations() {
+		if !codePattern.MatchString(string(explanation.Code)) {
+			testing.Errorf("code %q does not follow the pattern of codes", explanation.Code)
+		}
+		if explanation.Title == "" || explanation.Description == "" {
+			testing.Errorf("explanation of %s is incomplete", explanation.Code)
+		}
+		for _, example := range explanation.Examples {
+			if example.Erroneous == "" {
+				testing.Errorf("example of %s has no erroneous code", explanation.Code)
+			}
+		}
+	}
+}
+
+func TestSemanticPasses_RecordExplainedCodes(testing *testing.T) {
+	entries := runPass(testing, ControlFlowPassId, `
+method sign(value Number) returns Number
+  if value < 0
+    return 0 - 1
+`)
+	if len(entries) != 1 || entries[0].Code != CodeMissingReturn {
+		testing.Fatalf("expected the code %s to be recorded", CodeMissingReturn)
+	}
+	if _, ok := diagnostic.LookupExplanation(entries[0].Code); !ok {
+		testing.Errorf("expected the code %s to be explained", entries[0].Code)
+	}
+}
+
+// createExampleImportScope creates the import scope, in which the corrected
+// examples are analysed. Next to the trait App, it holds the generic class
+// Registry and the class Game, that are imported by some of the examples.
+func createExampleImportScope() scope.Scope {
+	importScope := createTraitImportScopeOfUnit("test", "run", "name").(scope.MutableScope)
+	app, _ := scope.LookupClass(importScope, scope.NewReferencePoint("App"))
+	registry := &scope.Class{
+		DeclarationName: "Registry",
+		QualifiedName:   "Registry",
+		ActualClass:     typing.NewEmptyClass("Registry"),
+		Parameters:      []*scope.Class{scope.NewTypeParameter("T", app)},
+	}
+	registry.Scope = scope.NewOuterScope("Registry", importScope)
+	registry.Scope.Insert(&scope.Method{
+		DeclarationName: "Length",
+		ReturnType:      scope.Builtins.Number,
+		EnclosingClass:  registry,
+	})
+	game := &scope.Class{
+		DeclarationName: "Game",
+		QualifiedName:   "Game",
+		ActualClass:     typing.NewEmptyClass("Game"),
+	}
+	game.Scope = scope.NewOuterScope("Game", importScope)
+	scope.AddTrait(game, app)
+	importScope.Insert(registry)
+	importScope.Insert(game)
+	return importScope
+}
+
+func TestExplanations_CorrectedExamplesAreValid(testing *testing.T) {
+	for _, explanation := range diagnostic.ListExplanations() {
+		if !strings.HasPrefix(string(explanation.Code), "S") {
+			continue
+		}
+		for _, example := range explanation.Examples {
+			if example.Corrected == "" {
+				continue
+			}
+			entries := runPassOnUnit(testing, CompletionPassId, "test",
+				example.Corrected, createExampleImportScope())
+			for _, entry := range entries {
+				testing.Errorf("corrected example of %s reports %s: %s",
+					explanation.Code, entry.Code, entry.Message)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package semantic
+
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+)
+
+func TestTypeCheckingPass_SubstitutesTypeParameters(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+let T = generic
+
+has value T
+
+method Get() returns T
+  return value
+
+method Put(element T)
+  value = element
+
+method Unwrap(box Test<Number>) returns Number
+  box.Put(1)
+  return box.Get()
+
+method Count(numbers List<Number>) returns Number
+  numbers.Add(1)
+  return numbers.Length()
+`)
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
+
+func TestTypeCheckingPass_ReportsMismatchedInstances(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+let T = generic
+
+has value T
+
+method Get() returns T
+  return value
+
+method Unwrap(box Test<String>) returns Number
+  return box.Get()
+
+method Fill(numbers List<Number>)
+  numbers.Add("text")
+`)
+	expectedMessages := []string{
+		diagnostic.FormatMessage(CodeInvalidReturn, "String", "Unwrap", "Number"),
+		diagnostic.FormatMessage(CodeInvalidArgument, "element", "Add", "Number", "String"),
+	}
+	for _, message := range expectedMessages {
+		if !containsMessage(entries, message) {
+			testing.Errorf("expected diagnostic %q", message)
+		}
+	}
+}
+
+func TestTypeCheckingPass_ReportsUnsatisfiedBounds(testing *testing.T) {
+	entries := runPassInScope(testing, TypeCheckingPassId, `
+let T = generic(App)
+
+has value T
+
+method Start()
+  value.Run()
+
+method Wrap(box Test<Number>)
+  box.Start()
+`, createTraitImportScope())
+	message := diagnostic.FormatMessage(CodeUnsatisfiedBound, "Number", "App", "T")
+	if !containsMessage(entries, message) {
+		testing.Errorf("expected diagnostic %q", message)
+	}
+}
+
+func TestTypeCheckingPass_AcceptsBoundsImplementedThroughTraits(testing *testing.T) {
+	entries := runPassInScope(testing, TypeCheckingPassId, `
+let T = generic(App)
+
+implement App
+
+method Run()
+  Name(True)
+
+method Name(short Boolean) returns String
+  return "Test"
+
+method Wrap(box Test<Test>)
+  box.Run()
+`, createTraitImportScope())
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
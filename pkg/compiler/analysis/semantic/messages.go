@@ -0,0 +1,124 @@
+package semantic
+
+import "github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+
+func init() {
+	diagnostic.RegisterMessages(diagnostic.DefaultLocale, map[diagnostic.Code]string{
+		CodeUnresolvedIdentifier:                       "could not resolve identifier %s",
+		CodeUnresolvedIdentifier.Qualify("suggestion"): "Did you mean %s?",
+		CodeUnresolvedIdentifier.Qualify("fix"):        "Replace %s with %s",
+		CodeUnresolvedCall:                             "could not resolve call",
+		CodeUnresolvedCall.Qualify("suggestion"):       "Did you mean %s?",
+		CodeUnresolvedCall.Qualify("fix"):              "Replace %s with %s",
+		CodeAmbiguousCall:                              "The call of %s is ambiguous between %d overloads",
+		CodeNoMatchingOverload:                         "No overload of %s accepts the arguments",
+		CodeFailedInference:                            "failed to resolve type",
+		CodeUnresolvedImport:                           "The namespace %s can not be imported, it is neither part of the package nor found on the search path",
+		CodeInvalidOperands:                            "The operator %s can not be applied to %s and %s",
+		CodeInvalidOperand:                             "The operator %s can not be applied to %s",
+		CodeInvalidAssign:                              "A value of %s can not be assigned to %s",
+		CodeArgumentCount:                              "The method %s expects %d arguments, but %d are passed",
+		CodeUnknownLabel:                               "The method %s has no parameter called %s",
+		CodeInvalidArgument:                            "The parameter %s of the method %s expects %s, but got %s",
+		CodeNonBooleanCondition:                        "The condition has to be a Boolean, but is %s",
+		CodeInvalidReturn:                              "A value of %s can not be returned from the method %s, which returns %s",
+		CodeUnsatisfiedBound:                           "The class %s does not implement %s, which bounds the type parameter %s",
+		CodeYieldOutsideOfMethod:                       "Values can only be yielded inside of methods",
+		CodeYieldingNonList:                            "The method %s yields values and has to return a list, but returns %s",
+		CodeInvalidYield:                               "A value of %s can not be yielded from the method %s, which yields %s",
+		CodeDuplicateArgument:                          "The parameter %s of the method %s is passed more than once",
+		CodeMissingReturn:                              "The method %s does not return a value on every path",
+		CodeUnreachableCode:                            "The statement is never executed, since the statements before it always leave the block",
+		CodeReturnInYieldingMethod:                     "The method %s yields its values and can not also return a value",
+		CodeIgnoredResult:                              "The Result of the expression is neither handled nor propagated, store it and check IsError() or propagate it with '?'",
+		CodePropagationOutsideOfResultMethod:           "The '?' operator can only be used in methods that return a Result",
+		CodePropagationOfNonResult:                     "The '?' operator can only be applied to expressions that evaluate to a Result",
+		CodeErrorOutsideOfReturn:                       "Error can only be returned from methods that return a Result",
+		CodeMatchOfNonUnion:                            "Only values of a union can be matched",
+		CodeUnknownUnionCase:                           "%s is not a case of the union %s",
+		CodeDuplicateMatchArm:                          "The case %s is matched by more than one arm",
+		CodeMisplacedDefaultArm:                        "The else arm has to be the last arm of the match",
+		CodeTooManyBindings:                            "The case %s carries %d values, but %d are bound",
+		CodeNonExhaustiveMatch:                         "The match does not cover the cases %s of the union %s, add arms for them or an else arm",
+		CodeUnknownTrait:                               "The implemented trait %s does not exist",
+		CodeMissingTraitMethod:                         "The class %s does not implement the method %s of the trait %s",
+		CodeMismatchedTraitMethod:                      "The method %s does not match the method of the trait %s, %s",
+		keyParameterCountMismatch:                      "it expects %d parameters instead of %d",
+		keyParameterClassMismatch:                      "its parameter %s has to be of %s instead of %s",
+		keyReturnClassMismatch:                         "it has to return %s instead of %s",
+		CodeUnassignedVariable:                         "The variable %s is used before it is assigned",
+		CodeReassignedBinding:                          "The name %s is bound by let and can not be reassigned",
+		CodeReassignedParameter:                        "The parameter %s can not be reassigned",
+		CodeUnusedVariable:                             "The variable %s is never used",
+		CodeUnusedVariable.Qualify("fix"):              "Remove the variable %s",
+		CodeUnusedField:                                "The field %s is never used",
+		CodeUnusedField.Qualify("fix"):                 "Remove the field %s",
+		CodeUnusedParameter:                            "The parameter %s is never used",
+		CodeUnusedImport:                               "The import of %s is never used",
+		CodeUnusedImport.Qualify("fix"):                "Remove the import of %s",
+		CodeInvalidModuleImport:                        "The imported modules name needs to be UpperCamelCase",
+		CodeInvalidUnitName:                            "The units name has to be lowerCamelCase",
+		CodeInvalidDeclarationName:                     "Declared identifiers must be named lowerCamelCase",
+		CodeInvalidDeclarationName.Qualify("fix"):      "Rename %s to %s",
+		CodeImplicitParameterName:                      "Parameters need explicit names if their type occurs more than once in the parameter list",
+	})
+	diagnostic.RegisterMessages("de", map[diagnostic.Code]string{
+		CodeUnresolvedIdentifier:                       "Der Bezeichner %s konnte nicht aufgelöst werden",
+		CodeUnresolvedIdentifier.Qualify("suggestion"): "Meintest du %s?",
+		CodeUnresolvedIdentifier.Qualify("fix"):        "Ersetze %s durch %s",
+		CodeUnresolvedCall:                             "Der Aufruf konnte nicht aufgelöst werden",
+		CodeUnresolvedCall.Qualify("suggestion"):       "Meintest du %s?",
+		CodeUnresolvedCall.Qualify("fix"):              "Ersetze %s durch %s",
+		CodeAmbiguousCall:                              "Der Aufruf von %s ist zwischen %d Überladungen mehrdeutig",
+		CodeNoMatchingOverload:                         "Keine Überladung von %s akzeptiert die Argumente",
+		CodeFailedInference:                            "Der Typ konnte nicht ermittelt werden",
+		CodeUnresolvedImport:                           "Der Namespace %s kann nicht importiert werden, er ist weder Teil des Pakets noch im Suchpfad zu finden",
+		CodeInvalidOperands:                            "Der Operator %s kann nicht auf %s und %s angewendet werden",
+		CodeInvalidOperand:                             "Der Operator %s kann nicht auf %s angewendet werden",
+		CodeInvalidAssign:                              "Ein Wert vom Typ %s kann %s nicht zugewiesen werden",
+		CodeArgumentCount:                              "Die Methode %s erwartet %d Argumente, aber es werden %d übergeben",
+		CodeUnknownLabel:                               "Die Methode %s hat keinen Parameter namens %s",
+		CodeInvalidArgument:                            "Der Parameter %s der Methode %s erwartet %s, erhielt aber %s",
+		CodeNonBooleanCondition:                        "Die Bedingung muss ein Boolean sein, ist aber %s",
+		CodeInvalidReturn:                              "Ein Wert vom Typ %s kann nicht aus der Methode %s zurückgegeben werden, die %s zurückgibt",
+		CodeUnsatisfiedBound:                           "Die Klasse %s implementiert nicht %s, was den Typparameter %s beschränkt",
+		CodeYieldOutsideOfMethod:                       "Werte können nur innerhalb von Methoden mit yield geliefert werden",
+		CodeYieldingNonList:                            "Die Methode %s liefert Werte mit yield und muss eine Liste zurückgeben, gibt aber %s zurück",
+		CodeInvalidYield:                               "Ein Wert vom Typ %s kann nicht aus der Methode %s geliefert werden, die %s liefert",
+		CodeDuplicateArgument:                          "Der Parameter %s der Methode %s wird mehrfach übergeben",
+		CodeMissingReturn:                              "Die Methode %s gibt nicht auf jedem Pfad einen Wert zurück",
+		CodeUnreachableCode:                            "Die Anweisung wird nie ausgeführt, da die Anweisungen davor den Block immer verlassen",
+		CodeReturnInYieldingMethod:                     "Die Methode %s liefert ihre Werte mit yield und kann nicht zusätzlich einen Wert zurückgeben",
+		CodeIgnoredResult:                              "Das Result des Ausdrucks wird weder behandelt noch weitergegeben, speichere es und prüfe IsError() oder gib es mit '?' weiter",
+		CodePropagationOutsideOfResultMethod:           "Der Operator '?' kann nur in Methoden verwendet werden, die ein Result zurückgeben",
+		CodePropagationOfNonResult:                     "Der Operator '?' kann nur auf Ausdrücke angewendet werden, die ein Result ergeben",
+		CodeErrorOutsideOfReturn:                       "Error kann nur aus Methoden zurückgegeben werden, die ein Result zurückgeben",
+		CodeMatchOfNonUnion:                            "Nur Werte einer Union können gematcht werden",
+		CodeUnknownUnionCase:                           "%s ist kein Fall der Union %s",
+		CodeDuplicateMatchArm:                          "Der Fall %s wird von mehr als einem Arm gematcht",
+		CodeMisplacedDefaultArm:                        "Der else-Arm muss der letzte Arm des Match sein",
+		CodeTooManyBindings:                            "Der Fall %s trägt %d Werte, aber es werden %d gebunden",
+		CodeNonExhaustiveMatch:                         "Das Match deckt die Fälle %s der Union %s nicht ab, füge Arme für sie oder einen else-Arm hinzu",
+		CodeUnknownTrait:                               "Der implementierte Trait %s existiert nicht",
+		CodeMissingTraitMethod:                         "Die Klasse %s implementiert die Methode %s des Traits %s nicht",
+		CodeMismatchedTraitMethod:                      "Die Methode %s passt nicht zur Methode des Traits %s, %s",
+		keyParameterCountMismatch:                      "sie erwartet %d Parameter statt %d",
+		keyParameterClassMismatch:                      "ihr Parameter %s muss vom Typ %s statt %s sein",
+		keyReturnClassMismatch:                         "sie muss %s statt %s zurückgeben",
+		CodeUnassignedVariable:                         "Die Variable %s wird verwendet, bevor ihr ein Wert zugewiesen wird",
+		CodeReassignedBinding:                          "Der Name %s ist mit let gebunden und kann nicht neu zugewiesen werden",
+		CodeReassignedParameter:                        "Dem Parameter %s kann kein neuer Wert zugewiesen werden",
+		CodeUnusedVariable:                             "Die Variable %s wird nie verwendet",
+		CodeUnusedVariable.Qualify("fix"):              "Entferne die Variable %s",
+		CodeUnusedField:                                "Das Feld %s wird nie verwendet",
+		CodeUnusedField.Qualify("fix"):                 "Entferne das Feld %s",
+		CodeUnusedParameter:                            "Der Parameter %s wird nie verwendet",
+		CodeUnusedImport:                               "Der Import von %s wird nie verwendet",
+		CodeUnusedImport.Qualify("fix"):                "Entferne den Import von %s",
+		CodeInvalidModuleImport:                        "Der Name des importierten Moduls muss UpperCamelCase sein",
+		CodeInvalidUnitName:                            "Der Name der Unit muss lowerCamelCase sein",
+		CodeInvalidDeclarationName:                     "Deklarierte Bezeichner müssen lowerCamelCase benannt sein",
+		CodeInvalidDeclarationName.Qualify("fix"):      "Benenne %s in %s um",
+		CodeImplicitParameterName:                      "Parameter brauchen explizite Namen, wenn ihr Typ mehr als einmal in der Parameterliste vorkommt",
+	})
+}
@@ -0,0 +1,40 @@
+package semantic
+
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+)
+
+func TestMessages_AreTranslatedToGerman(testing *testing.T) {
+	german := diagnostic.ListMessages("de")
+	for code, template := range diagnostic.ListMessages(diagnostic.DefaultLocale) {
+		translation, ok := german[code]
+		if !ok {
+			testing.Errorf("message %s is not translated to german", code)
+			continue
+		}
+		if err := diagnostic.CheckTranslation(template, translation); err != nil {
+			testing.Errorf("translation of %s is invalid: %v", code, err)
+		}
+	}
+}
+
+func TestMessages_AreReportedInSelectedLocale(testing *testing.T) {
+	if err := diagnostic.SelectLocale("de"); err != nil {
+		testing.Fatal(err)
+	}
+	defer diagnostic.SelectLocale(diagnostic.DefaultLocale)
+	entries := runPass(testing, UnusedSymbolPassId, `
+method increment(amount Number) returns Number
+  has ignored Number
+  return amount
+`)
+	entry, ok := findEntryWithMessage(entries, "Die Variable ignored wird nie verwendet")
+	if !ok {
+		testing.Fatal("expected the unused variable to be reported in german")
+	}
+	if len(entry.Fixes) == 0 || entry.Fixes[0].Title != "Entferne die Variable ignored" {
+		testing.Errorf("expected the fix to be titled in german: %v", entry.Fixes)
+	}
+}
@@ -1,6 +1,7 @@
 package semantic
 
 import (
+	"github.com/strict-lang/sdk/pkg/compiler/analysis"
 	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/token"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
@@ -11,6 +12,15 @@ import (
 	"log"
 )
 
+const (
+	CodeUnresolvedIdentifier diagnostic.Code = "S0101"
+	CodeUnresolvedCall       diagnostic.Code = "S0102"
+	CodeAmbiguousCall        diagnostic.Code = "S0103"
+	CodeNoMatchingOverload   diagnostic.Code = "S0104"
+	CodeFailedInference      diagnostic.Code = "S0105"
+	CodeUnresolvedImport     diagnostic.Code = "S0106"
+)
+
 const NameResolutionPassId = "NameResolutionPass"
 
 func init() {
@@ -25,9 +35,26 @@ type NameResolutionPass struct {
 func (pass *NameResolutionPass) Run(context *passes.Context) {
 	pass.context = context
 	pass.visitor = pass.createVisitor()
+	pass.reportUnresolvedImports()
 	context.Unit.AcceptRecursive(pass.visitor)
 }
 
+// reportUnresolvedImports reports the imports, whose namespaces could not
+// be resolved when the analysis of the unit was created.
+func (pass *NameResolutionPass) reportUnresolvedImports() {
+	imports := analysis.RequireInIsolate(pass.context.Isolate).UnresolvedImports
+	for _, statement := range imports {
+		pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+			Kind:     &diagnostic.Error,
+			Stage:    &diagnostic.SemanticAnalysis,
+			Message:  diagnostic.FormatMessage(CodeUnresolvedImport, statement.ImportedNamespace()),
+			Code:     CodeUnresolvedImport,
+			UnitName: pass.context.Unit.Name,
+			Position: statement.Locate(),
+		})
+	}
+}
+
 func (pass *NameResolutionPass) Dependencies(isolate *isolate.Isolate) passes.Set {
 	return passes.EmptySet
 }
@@ -44,7 +71,12 @@ func (pass *NameResolutionPass) createVisitor() tree.Visitor {
 	visitor.NumberLiteralVisitor = pass.visitNumberLiteral
 	visitor.BinaryExpressionVisitor = pass.visitBinaryExpression
 	visitor.UnaryExpressionVisitor = pass.visitUnaryExpression
+	visitor.PropagationExpressionVisitor = pass.visitPropagationExpression
+	visitor.ListExpressionVisitor = pass.visitListExpression
+	visitor.MapExpressionVisitor = pass.visitMapExpression
 	visitor.LetBindingVisitor = pass.visitLetExpression
+	visitor.FieldSelectExpressionVisitor = pass.visitChainExpression
+	visitor.MatchStatementVisitor = pass.visitMatchStatement
 	visitor.ForEachLoopStatementVisitor = pass.visitForEachLoop
 	visitor.RangedLoopStatementVisitor = pass.visitRangedLoop
 	return visitor
@@ -57,8 +89,7 @@ func (pass *NameResolutionPass) visitIdentifier(identifier *tree.Identifier) {
 }
 
 func (pass *NameResolutionPass) resolveIdentifier(identifier *tree.Identifier) {
-	searchScope := pass.selectResolutionScope(identifier)
-	if entries := searchScope.Lookup(identifier.ReferencePoint()); !entries.IsEmpty() {
+	if entries := pass.lookup(identifier, identifier.ReferencePoint()); !entries.IsEmpty() {
 		symbol := pass.selectIdentifierLookupEntry(identifier, entries)
 		identifier.Bind(symbol)
 		identifier.ResolveType(pass.resolveFieldSymbolType(symbol))
@@ -127,12 +158,13 @@ func (pass *NameResolutionPass) visitCallExpression(call *tree.CallExpression) {
 
 func (pass *NameResolutionPass) resolveCallExpression(call *tree.CallExpression) {
 	if name, ok := call.TargetName(); ok && !name.IsBound() {
-		searchScope := pass.selectResolutionScope(call)
-		if entries := searchScope.Lookup(name.ReferencePoint()); !entries.IsEmpty() {
+		if entries := pass.lookup(call, name.ReferencePoint()); !entries.IsEmpty() {
+			if overloads := scope.ListMethods(entries); len(overloads) > 1 {
+				pass.resolveOverloadedCall(call, name, overloads)
+				return
+			}
 			if methodSymbol, ok := scope.AsMethodSymbol(entries.First().Symbol); ok {
-				name.Bind(methodSymbol)
-				name.ResolveType(methodSymbol.ReturnType)
-				call.ResolveType(methodSymbol.ReturnType)
+				bindCalledMethod(call, name, methodSymbol)
 				return
 			}
 		}
@@ -140,16 +172,102 @@ func (pass *NameResolutionPass) resolveCallExpression(call *tree.CallExpression)
 	pass.resolveUnresolvedCall(call)
 }
 
+func bindCalledMethod(
+	call *tree.CallExpression, name *tree.Identifier, method *scope.Method) {
+
+	name.Bind(method)
+	name.ResolveType(method.ReturnType)
+	call.ResolveType(method.ReturnType)
+}
+
+// resolveOverloadedCall selects the overload, that accepts the labels and
+// classes of the calls arguments. Calls that are accepted by none or by
+// multiple overloads are reported and left unbound.
+func (pass *NameResolutionPass) resolveOverloadedCall(
+	call *tree.CallExpression, name *tree.Identifier, overloads []*scope.Method) {
+
+	selected := scope.SelectOverloads(overloads, pass.resolveArguments(call))
+	switch len(selected) {
+	case 1:
+		bindCalledMethod(call, name, selected[0])
+		return
+	case 0:
+		pass.reportInvalidCall(call, CodeNoMatchingOverload, name.Value)
+	default:
+		pass.reportInvalidCall(call, CodeAmbiguousCall, name.Value, len(selected))
+	}
+	call.ResolveType(scope.Builtins.Any)
+}
+
+// resolveArguments resolves the values of the calls arguments before the
+// call itself is resolved, since their classes are used to select overloads.
+func (pass *NameResolutionPass) resolveArguments(
+	call *tree.CallExpression) (arguments []scope.Argument) {
+
+	for _, argument := range call.Arguments {
+		arguments = append(arguments, scope.Argument{
+			Label: argument.Label,
+			Class: pass.resolveExpression(argument.Value),
+		})
+	}
+	return arguments
+}
+
+func (pass *NameResolutionPass) reportInvalidCall(
+	call *tree.CallExpression, code diagnostic.Code, arguments ...interface{}) {
+
+	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+		Kind:     &diagnostic.Error,
+		Stage:    &diagnostic.SemanticAnalysis,
+		Message:  diagnostic.FormatMessage(code, arguments...),
+		Code:     code,
+		UnitName: pass.context.Unit.Name,
+		Position: call.Locate(),
+	})
+}
+
 func (pass *NameResolutionPass) resolveUnresolvedCall(call *tree.CallExpression) {
 	log.Print("could not resolve call")
-	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+	entry := diagnostic.RecordedEntry{
 		Kind:     &diagnostic.Error,
 		Stage:    &diagnostic.SemanticAnalysis,
-		Message:  "could not resolve call",
+		Message:  diagnostic.FormatMessage(CodeUnresolvedCall),
+		Code:     CodeUnresolvedCall,
 		UnitName: pass.context.Unit.Name,
 		Error:    nil,
 		Position: call.Locate(),
-	})
+	}
+	if name, ok := call.TargetName(); ok && !name.IsBound() {
+		pass.suggestSimilarNames(&entry, name)
+	}
+	pass.context.Diagnostic.Record(entry)
+}
+
+// lookup searches the resolution scope of the node for symbols that match the
+// point. If the node is selected from a chain and its class has no such member,
+// the extension methods that are visible at the node are searched instead.
+func (pass *NameResolutionPass) lookup(
+	node tree.Expression, point scope.ReferencePoint) scope.EntrySet {
+
+	searchScope := pass.selectResolutionScope(node)
+	if entries := searchScope.Lookup(point); !entries.IsEmpty() {
+		return entries
+	}
+	return pass.lookupExtension(node, point)
+}
+
+func (pass *NameResolutionPass) lookupExtension(
+	node tree.Expression, point scope.ReferencePoint) scope.EntrySet {
+
+	if chain, ok := tree.SearchEnclosingChain(node); ok {
+		if receiver, ok := findSelectedClassInChain(node, chain); ok {
+			visibleScope := pass.selectResolutionScopeWithoutQualifier(node)
+			if extension, ok := scope.LookupExtension(visibleScope, receiver, point); ok {
+				return scope.EntrySet{{Symbol: extension}}
+			}
+		}
+	}
+	return scope.EntrySet{}
 }
 
 func (pass *NameResolutionPass) selectResolutionScope(node tree.Expression) scope.Scope {
@@ -174,14 +292,26 @@ func (pass *NameResolutionPass) selectResolutionScopeInChain(
 	node tree.Node,
 	chain *tree.ChainExpression) scope.Scope {
 
+	if findIndexInChain(node.Locate().Begin(), chain) == 0 {
+		return pass.selectResolutionScopeWithoutQualifier(chain)
+	}
+	if lastType, ok := findSelectedClassInChain(node, chain); ok {
+		return lastType.Scope
+	}
+	return scope.NewEmptyScope("not_found")
+}
+
+// findSelectedClassInChain returns the class of the element that precedes
+// the node in the chain. Its members are selected by the node.
+func findSelectedClassInChain(
+	node tree.Node, chain *tree.ChainExpression) (*scope.Class, bool) {
+
 	index := findIndexInChain(node.Locate().Begin(), chain)
 	formerIndex := index - 1
 	if formerIndex >= 0 && formerIndex < len(chain.Expressions) {
-		if lastType, ok := chain.Expressions[formerIndex].ResolvedType(); ok {
-			return lastType.Scope
-		}
+		return chain.Expressions[formerIndex].ResolvedType()
 	}
-	return scope.NewEmptyScope("not_found")
+	return nil, false
 }
 
 func findIndexInChain(position input.Offset, chain *tree.ChainExpression) int {
@@ -195,14 +325,62 @@ func findIndexInChain(position input.Offset, chain *tree.ChainExpression) int {
 
 func (pass *NameResolutionPass) reportUnresolvedField(identifier *tree.Identifier) {
 	log.Printf("could not resolve field %s", identifier.Value)
-	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+	entry := diagnostic.RecordedEntry{
 		Kind:     &diagnostic.Error,
 		Stage:    &diagnostic.SemanticAnalysis,
-		Message:  "could not resolve identifier " + identifier.Value,
+		Message:  diagnostic.FormatMessage(CodeUnresolvedIdentifier, identifier.Value),
+		Code:     CodeUnresolvedIdentifier,
 		UnitName: pass.context.Unit.Name,
 		Error:    nil,
 		Position: identifier.Locate(),
-	})
+	}
+	pass.suggestSimilarNames(&entry, identifier)
+	pass.context.Diagnostic.Record(entry)
+}
+
+// suggestSimilarNames attaches the names, that are visible at the unresolved
+// identifier and close to its value, to the entry. Each name is attached as
+// a fix, that replaces the identifier. The closest name is preferred.
+func (pass *NameResolutionPass) suggestSimilarNames(
+	entry *diagnostic.RecordedEntry, identifier *tree.Identifier) {
+
+	suggestions := suggestSimilarNames(identifier.Value, pass.listVisibleNames(identifier))
+	if len(suggestions) == 0 {
+		return
+	}
+	richError := &diagnostic.RichError{
+		Error: &diagnostic.SpecificError{Message: entry.Message, ErrorCode: entry.Code},
+	}
+	for _, suggestion := range suggestions {
+		richError.CommonReasons = append(richError.CommonReasons,
+			diagnostic.FormatMessage(entry.Code.Qualify("suggestion"), suggestion))
+		richError.Fixes = append(richError.Fixes, diagnostic.NewReplacementFix(
+			diagnostic.FormatMessage(entry.Code.Qualify("fix"), identifier.Value, suggestion),
+			identifier.Locate(),
+			suggestion))
+	}
+	entry.Error = richError
+	entry.Fix = richError.Fixes[0]
+}
+
+// listVisibleNames lists the distinct names of the symbols, that can be
+// referenced at the position of the identifier.
+func (pass *NameResolutionPass) listVisibleNames(identifier *tree.Identifier) (names []string) {
+	searchScope := pass.selectResolutionScope(identifier)
+	listed := map[string]bool{}
+	entries := searchScope.Search(func(scope.Symbol) bool { return true })
+	for _, entry := range entries {
+		name := entry.Symbol.Name()
+		if listed[name] || name == "" {
+			continue
+		}
+		listed[name] = true
+		point := scope.NewReferencePointWithPosition(name, identifier.Region.Begin())
+		if !searchScope.Lookup(point).IsEmpty() {
+			names = append(names, name)
+		}
+	}
+	return names
 }
 
 func (pass *NameResolutionPass) visitStringLiteral(string *tree.StringLiteral) {
@@ -248,19 +426,129 @@ func (pass *NameResolutionPass) visitBinaryExpression(binary *tree.BinaryExpress
 	pass.reportFailedInference(binary)
 }
 
+// visitLetExpression infers the class of the bound name from the bound
+// expression. Bindings that destructure the expression into multiple names
+// are not yet inferred.
 func (pass *NameResolutionPass) visitLetExpression(binding *tree.LetBinding) {
 	expressionClass := pass.resolveExpression(binding.Expression)
 	binding.ResolveType(expressionClass)
+	if len(binding.Names) == 1 {
+		inferVariableClass(binding.Names[0], expressionClass)
+	}
+}
+
+// inferVariableClass resolves the class of a variable, that is declared
+// without a type. The class is stored in the symbol of the variable, so that
+// every identifier referring to the variable is resolved to it.
+func inferVariableClass(name *tree.Identifier, class *scope.Class) {
+	if class == nil {
+		return
+	}
+	name.ResolveType(class)
+	if field, ok := scope.AsFieldSymbol(name.Binding()); ok && field.Class == nil {
+		field.Class = class
+	}
+}
+
+// visitChainExpression resolves the elements of the chain from left to right,
+// since every element is looked up in the class of its predecessor. The class
+// of the chain is the class of its last element.
+func (pass *NameResolutionPass) visitChainExpression(chain *tree.ChainExpression) {
+	if isResolved(chain) {
+		return
+	}
+	for _, element := range chain.Expressions {
+		if pass.resolveExpression(element) == nil {
+			return
+		}
+	}
+	lastClass, _ := chain.LastChild().ResolvedType()
+	chain.ResolveType(lastClass)
 }
 
 func (pass *NameResolutionPass) visitForEachLoop(loop *tree.ForEachLoopStatement) {
-	sequenceClass := pass.resolveExpression(loop.Sequence)
-	loop.Field.ResolveType(sequenceClass)
+	if sequenceClass := pass.resolveExpression(loop.Sequence); sequenceClass != nil {
+		inferVariableClass(loop.Field, scope.ElementClass(sequenceClass))
+	}
+}
+
+// visitMatchStatement resolves the cases of the arms and the classes of their
+// bindings, which are the classes of the payload of the matched case. Arms
+// that do not match a case of the union are reported by the UnionMatchingPass.
+func (pass *NameResolutionPass) visitMatchStatement(match *tree.MatchStatement) {
+	subjectClass := pass.resolveExpression(match.Subject)
+	if subjectClass == nil || !scope.IsUnionClass(subjectClass) {
+		return
+	}
+	for _, arm := range match.Arms {
+		if arm.IsDefault() {
+			continue
+		}
+		if constructor, ok := scope.LookupUnionCase(subjectClass, arm.Case.Value); ok {
+			arm.Case.Bind(constructor)
+			arm.Case.ResolveType(subjectClass)
+			pass.resolveMatchBindings(arm, constructor)
+		}
+	}
+}
+
+func (pass *NameResolutionPass) resolveMatchBindings(
+	arm *tree.MatchArm, constructor *scope.Method) {
+
+	for index, binding := range arm.Bindings {
+		if index >= len(constructor.Parameters) {
+			return
+		}
+		class := constructor.Parameters[index].Class
+		binding.ResolveType(class)
+		entries := arm.Body.Scope().Lookup(scope.NewReferencePoint(binding.Value))
+		if entries.IsEmpty() {
+			continue
+		}
+		if field, ok := scope.AsFieldSymbol(entries.First().Symbol); ok {
+			field.Class = class
+			binding.Bind(field)
+		}
+	}
+}
+
+// visitListExpression resolves the class of a list literal. The class of its
+// elements is the class of the first element or Any, if the list is empty.
+func (pass *NameResolutionPass) visitListExpression(list *tree.ListExpression) {
+	if isResolved(list) {
+		return
+	}
+	list.ResolveType(scope.NewListClass(pass.resolveFirstClass(list.Expressions)))
+}
+
+// visitMapExpression resolves the class of a map literal from the classes of
+// its first entry. Empty maps have keys and values of the class Any.
+func (pass *NameResolutionPass) visitMapExpression(expression *tree.MapExpression) {
+	if isResolved(expression) {
+		return
+	}
+	var keys, values []tree.Expression
+	for _, entry := range expression.Entries {
+		keys = append(keys, entry.Key)
+		values = append(values, entry.Value)
+	}
+	keyClass := pass.resolveFirstClass(keys)
+	valueClass := pass.resolveFirstClass(values)
+	expression.ResolveType(scope.NewMapClass(keyClass, valueClass))
+}
+
+func (pass *NameResolutionPass) resolveFirstClass(expressions []tree.Expression) *scope.Class {
+	if len(expressions) != 0 {
+		if class := pass.resolveExpression(expressions[0]); class != nil {
+			return class
+		}
+	}
+	return scope.Builtins.Any
 }
 
 func (pass *NameResolutionPass) visitRangedLoop(loop *tree.RangedLoopStatement) {
 	indexClass := pass.resolveExpression(loop.Begin)
-	loop.Field.ResolveType(indexClass)
+	inferVariableClass(loop.Field, indexClass)
 }
 
 func (pass *NameResolutionPass) visitUnaryExpression(unary *tree.UnaryExpression) {
@@ -277,12 +565,31 @@ func (pass *NameResolutionPass) visitUnaryExpression(unary *tree.UnaryExpression
 	pass.reportFailedInference(unary)
 }
 
+// visitPropagationExpression resolves the type of a propagation to the class
+// of the value, that is held by the propagated Result. The operand is
+// resolved first, since it may be a chain whose elements are not yet visited.
+func (pass *NameResolutionPass) visitPropagationExpression(
+	propagation *tree.PropagationExpression) {
+
+	if isResolved(propagation) {
+		return
+	}
+	propagation.Operand.AcceptRecursive(pass.visitor)
+	if operandClass, ok := propagation.Operand.ResolvedType(); ok {
+		propagation.ResolveType(scope.ResultValueClass(operandClass))
+		return
+	}
+	propagation.ResolveType(scope.Builtins.Any)
+	pass.reportFailedInference(propagation)
+}
+
 func (pass *NameResolutionPass) reportFailedInference(node tree.Node) {
 	log.Print("could not infer type")
 	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
 		Kind:     &diagnostic.Error,
 		Stage:    &diagnostic.SemanticAnalysis,
-		Message:  "failed to resolve type",
+		Message:  diagnostic.FormatMessage(CodeFailedInference),
+		Code:     CodeFailedInference,
 		UnitName: pass.context.Unit.Name,
 		Position: node.Locate(),
 	})
@@ -2,6 +2,7 @@ package semantic
 
 import (
 	"github.com/strict-lang/sdk/pkg/compiler/analysis"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis/entering"
 	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/syntax"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
@@ -10,6 +11,7 @@ import (
 	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
 	"github.com/strict-lang/sdk/pkg/compiler/scope"
 	"log"
+	"strings"
 	"testing"
 )
 
@@ -53,10 +55,140 @@ func TestNameResolutionPass(testing *testing.T) {
 }
 
 func createImportScope() scope.Scope {
+	return createImportScopeOfUnit("Test")
+}
+
+func createImportScopeOfUnit(name string) scope.Scope {
 	testScope := scope.NewOuterScope(scope.Id("test-scope"), scope.NewBuiltinScope())
 	testScope.Insert(&scope.Class{
-		DeclarationName: "Test",
-		QualifiedName:   "Test",
+		DeclarationName: name,
+		QualifiedName:   name,
 	})
 	return testScope
 }
+
+func findEntryWithMessage(entries []diagnostic.Entry, message string) (diagnostic.Entry, bool) {
+	for _, entry := range entries {
+		if entry.Message == message {
+			return entry, true
+		}
+	}
+	return diagnostic.Entry{}, false
+}
+
+func TestNameResolutionPass_SuggestsSimilarNames(testing *testing.T) {
+	entries := runPass(testing, NameResolutionPassId, `
+method CalculateTotal(values Number[]) returns Number
+  let totalCount = 0
+  for value in values
+    totalCount = totalCount + value
+  return countTotal
+
+method Run()
+  let result = calculateTotl()
+  let unrelated = somethingElse
+`)
+	entry, ok := findEntryWithMessage(entries, "could not resolve identifier countTotal")
+	if !ok {
+		testing.Fatalf("expected the unresolved identifier to be reported")
+	}
+	if entry.Error == nil || len(entry.Error.CommonReasons) == 0 ||
+		entry.Error.CommonReasons[0] != "Did you mean totalCount?" {
+		testing.Errorf("expected totalCount to be suggested, got %+v", entry.Error)
+	}
+	if len(entry.Fixes) == 0 || entry.Fixes[0].Edits[0].Replacement != "totalCount" {
+		testing.Fatalf("expected a fix that replaces the identifier with totalCount")
+	}
+	if entry.Fixes[0].MachineApplicable {
+		testing.Errorf("expected the suggested replacement to not be machine-applicable")
+	}
+	call, ok := findEntryWithMessage(entries, "could not resolve call")
+	if !ok || len(call.Fixes) == 0 || call.Fixes[0].Edits[0].Replacement != "CalculateTotal" {
+		testing.Errorf("expected CalculateTotal to be suggested for the call")
+	}
+	unrelated, ok := findEntryWithMessage(entries, "could not resolve identifier somethingElse")
+	if !ok || len(unrelated.Fixes) != 0 || unrelated.Error != nil {
+		testing.Errorf("expected no suggestion for an unrelated name")
+	}
+}
+
+func TestNameResolutionPass_ResolvesExtensionCallsInChain(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+method Test.twin() returns Test
+  return self
+
+method String.shout() returns String
+  return self
+
+method Size() returns Number
+  return 1
+
+method Measure(other Test) returns Number
+  return other.twin().Size()
+
+method Shout(text String) returns Number
+  return text.shout().shout().Length()
+`)
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
+
+func TestNameResolutionPass_ReportsUnresolvedImports(testing *testing.T) {
+	result := syntax.ParseString("Test", `
+import Strict.Unknown
+
+method Run()
+  let value = 1
+`)
+	if result.Error != nil {
+		testing.Fatalf("failed to parse Unit: %v", result.Error)
+	}
+	isolate := isolates.New()
+	testAnalysis := analysis.Analysis{
+		ImportScope:       createImportScope(),
+		UnresolvedImports: result.TranslationUnit.Imports,
+	}
+	testAnalysis.Store(isolate)
+	context := &passes.Context{
+		Unit:       result.TranslationUnit,
+		Diagnostic: diagnostic.NewBag(),
+		Isolate:    isolate,
+	}
+	if err := entering.Run(context); err != nil {
+		testing.Fatal(err)
+	}
+	if err := passes.RunWithId(NameResolutionPassId, context); err != nil {
+		testing.Fatal(err)
+	}
+	converter := diagnostic.ConvertWithLineMap(result.LineMap)
+	entries := context.Diagnostic.CreateDiagnostics(converter).ListEntries()
+	if len(entries) != 1 || entries[0].Code != CodeUnresolvedImport {
+		testing.Fatalf("expected the import to be reported, got %+v", entries)
+	}
+	if entries[0].Position.Begin.Line.Index != 2 {
+		testing.Errorf("expected the import statement to be reported, got line %d",
+			entries[0].Position.Begin.Line.Index)
+	}
+}
+
+func TestSuggestSimilarNames(testing *testing.T) {
+	entries := []struct {
+		name       string
+		candidates []string
+		expected   []string
+	}{
+		{"lenght", []string{"length", "height", "width"}, []string{"length", "height"}},
+		{"Count", []string{"count", "amount"}, []string{"count"}},
+		{"hashcodeValue", []string{"hashCodeValue"}, []string{"hashCodeValue"}},
+		{"nameOfUser", []string{"userOfName", "unrelated"}, []string{"userOfName"}},
+		{"x", []string{"y", "z"}, []string{}},
+	}
+	for _, entry := range entries {
+		suggestions := suggestSimilarNames(entry.name, entry.candidates)
+		if strings.Join(suggestions, ",") != strings.Join(entry.expected, ",") {
+			testing.Errorf("suggestions for %s: got %v, expected %v",
+				entry.name, suggestions, entry.expected)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package semantic
 
 import (
+	"strings"
+
 	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
 	"github.com/strict-lang/sdk/pkg/compiler/isolate"
@@ -8,11 +10,10 @@ import (
 )
 
 const (
-	MessageInvalidModuleImport    = "The imported modules name needs to be UpperCamelCase"
-	MessageInvalidUnitName        = "The units name has to be lowerCamelCase"
-	MessageInvalidDeclarationName = "Declared identifiers must be named lowerCamelCase"
-	MessageImplicitParameterName  = "Parameters need explicit names if their type occurs more" +
-		"than once in the parameter list"
+	CodeInvalidModuleImport    diagnostic.Code = "S0901"
+	CodeInvalidUnitName        diagnostic.Code = "S0902"
+	CodeInvalidDeclarationName diagnostic.Code = "S0903"
+	CodeImplicitParameterName  diagnostic.Code = "S0904"
 )
 
 const NamingCheckPassId = "NamingCheckPass"
@@ -31,6 +32,8 @@ type NamingCheckPass struct {
 }
 
 func (pass *NamingCheckPass) Run(context *passes.Context) {
+	pass.recorder = context.Diagnostic
+	pass.unit = context.Unit
 	visitor := pass.createVisitor()
 	context.Unit.AcceptRecursive(visitor)
 }
@@ -52,26 +55,95 @@ func (pass *NamingCheckPass) createVisitor() tree.Visitor {
 	visitor.ImportStatementVisitor = pass.checkImportedModuleNaming
 	visitor.ForEachLoopStatementVisitor = pass.checkForEachLoopFieldNaming
 	visitor.RangedLoopStatementVisitor = pass.checkRangedLoopFieldNaming
+	visitor.LetBindingVisitor = pass.checkLetBindingNaming
+	visitor.FieldDeclarationVisitor = pass.checkFieldDeclarationNaming
 	return visitor
 }
 
 // reportInvalidNode reports that the node has an invalid name.
-func (pass *NamingCheckPass) reportInvalidNode(node tree.Node, message string) {
+func (pass *NamingCheckPass) reportInvalidNode(
+	node tree.Node, code diagnostic.Code) {
+
+	pass.reportInvalidNodeWithFix(node, code, nil)
+}
+
+func (pass *NamingCheckPass) reportInvalidNodeWithFix(
+	node tree.Node, code diagnostic.Code, fix *diagnostic.Fix) {
+
 	pass.recorder.Record(diagnostic.RecordedEntry{
 		Position: node.Locate(),
 		UnitName: pass.unit.Name,
 		Kind:     &diagnostic.Error,
 		Stage:    &diagnostic.SemanticAnalysis,
-		Message:  message,
+		Message:  diagnostic.FormatMessage(code),
+		Code:     code,
+		Fix:      fix,
 	})
 }
 
+// reportInvalidLocalName reports the invalid name of a local declaration. It
+// attaches a fix, that renames the declaration and every reference to it in
+// the enclosing method. Names of members are referenced by other units and
+// parameter names are used as argument labels, they are thus not renamed.
+func (pass *NamingCheckPass) reportInvalidLocalName(name *tree.Identifier) {
+	method, ok := tree.SearchEnclosingMethod(name)
+	renamed := convertToLowerCamelCase(name.Value)
+	if !ok || !isLowerCamelCase(renamed) {
+		pass.reportInvalidNode(name, CodeInvalidDeclarationName)
+		return
+	}
+	title := diagnostic.FormatMessage(
+		CodeInvalidDeclarationName.Qualify("fix"), name.Value, renamed)
+	fix := &diagnostic.Fix{Title: title, MachineApplicable: true}
+	for _, reference := range findLocalReferences(method, name.Value) {
+		fix.Edits = append(fix.Edits, diagnostic.Edit{
+			Position:    reference.Locate(),
+			Replacement: renamed,
+		})
+	}
+	pass.reportInvalidNodeWithFix(name, CodeInvalidDeclarationName, fix)
+}
+
+// findLocalReferences finds the identifiers in the method, that have the name.
+// Identifiers that select a member in a chain are not local references.
+func findLocalReferences(
+	method *tree.MethodDeclaration, name string) (references []*tree.Identifier) {
+
+	method.AcceptRecursive(tree.VisitWith(func(node tree.Node) {
+		identifier, ok := node.(*tree.Identifier)
+		if ok && identifier.Value == name && !isSelectedMember(identifier) {
+			references = append(references, identifier)
+		}
+	}))
+	return references
+}
+
+func isSelectedMember(identifier *tree.Identifier) bool {
+	chain, ok := identifier.Parent.(*tree.ChainExpression)
+	return ok && findIndexInChain(identifier.Region.Begin(), chain) != 0
+}
+
+// convertToLowerCamelCase converts names in other cases, like snake_case or
+// UpperCamelCase, into lowerCamelCase.
+func convertToLowerCamelCase(name string) string {
+	var converted strings.Builder
+	for index, word := range splitCamelCase(name) {
+		if index == 0 {
+			converted.WriteString(word)
+			continue
+		}
+		converted.WriteString(strings.ToUpper(word[:1]))
+		converted.WriteString(word[1:])
+	}
+	return converted.String()
+}
+
 // checkImportedModuleNaming ensures that the name of the imported module is upper camel case.
 // Either by importing a file which starts with an upper case character or by having an
 // alias that is upper camel case. Everything else results in a semantic error.
 func (pass *NamingCheckPass) checkImportedModuleNaming(statement *tree.ImportStatement) {
 	if isUpperCamelCase(statement.ModuleName()) {
-		pass.reportInvalidNode(statement, MessageInvalidModuleImport)
+		pass.reportInvalidNode(statement, CodeInvalidModuleImport)
 	}
 }
 
@@ -79,7 +151,7 @@ func (pass *NamingCheckPass) checkImportedModuleNaming(statement *tree.ImportSta
 // lowerCamelCase.
 func (pass *NamingCheckPass) checkRangedLoopFieldNaming(loop *tree.RangedLoopStatement) {
 	if !isLowerCamelCase(loop.Field.Value) {
-		pass.reportInvalidNode(loop.Field, MessageInvalidDeclarationName)
+		pass.reportInvalidLocalName(loop.Field)
 	}
 }
 
@@ -87,7 +159,34 @@ func (pass *NamingCheckPass) checkRangedLoopFieldNaming(loop *tree.RangedLoopSta
 // lowerCamelCase.
 func (pass *NamingCheckPass) checkForEachLoopFieldNaming(loop *tree.ForEachLoopStatement) {
 	if !isLowerCamelCase(loop.Field.Value) {
-		pass.reportInvalidNode(loop.Field, MessageInvalidDeclarationName)
+		pass.reportInvalidLocalName(loop.Field)
+	}
+}
+
+// checkLetBindingNaming ensures that the names, which are bound by a let
+// binding, are lowerCamelCase.
+func (pass *NamingCheckPass) checkLetBindingNaming(binding *tree.LetBinding) {
+	for _, name := range binding.Names {
+		if !isLowerCamelCase(name.Value) {
+			pass.reportInvalidLocalName(name)
+		}
+	}
+}
+
+// checkFieldDeclarationNaming ensures that declared fields and variables are
+// lowerCamelCase. Exported fields of the class are UpperCamelCase instead.
+// Fields that are inferred by the compiler are not checked.
+func (pass *NamingCheckPass) checkFieldDeclarationNaming(declaration *tree.FieldDeclaration) {
+	if declaration.Inferred || isLowerCamelCase(declaration.Name.Value) {
+		return
+	}
+	if declaration.IsExported() && isUpperCamelCase(declaration.Name.Value) {
+		return
+	}
+	if tree.IsInsideOfMethod(declaration) {
+		pass.reportInvalidLocalName(declaration.Name)
+	} else {
+		pass.reportInvalidNode(declaration.Name, CodeInvalidDeclarationName)
 	}
 }
 
@@ -95,7 +194,7 @@ func (pass *NamingCheckPass) checkForEachLoopFieldNaming(loop *tree.ForEachLoopS
 // a Strict type, it has to be lowerCamelCase.
 func (pass *NamingCheckPass) checkTranslationUnitNaming(unit *tree.TranslationUnit) {
 	if !isLowerCamelCase(unit.ToTypeName().BaseName()) {
-		pass.reportInvalidNode(unit, MessageInvalidUnitName)
+		pass.reportInvalidNode(unit, CodeInvalidUnitName)
 	}
 }
 
@@ -108,37 +207,45 @@ func (pass *NamingCheckPass) checkFieldNaming(method *tree.AssignStatement) {
 		return
 	}
 	if !isLowerCamelCase(identifier.Value) {
-		pass.reportInvalidNode(identifier, MessageInvalidDeclarationName)
+		pass.reportInvalidNode(identifier, CodeInvalidDeclarationName)
 	}
 }
 
 // checkMethodNamingAndImplicitParameters ensures that a methods name is lowerCamelCase and that
 // its parameters have explicit names if their type occurs more than once in the ParameterList.
-// Meaning that when the ParameterList contains two numbers: '(number, number x)', both of the
-// parameters need an explicit name: '(number x, number y)'.
+// A parameter is named implicitly, if its name is the name of its type. Meaning that when the
+// ParameterList contains two numbers: '(number Number, x Number)', both of the parameters need
+// an explicit name: '(x Number, y Number)'.
 func (pass *NamingCheckPass) checkMethodNamingAndImplicitParameters(method *tree.MethodDeclaration) {
 	if !isLowerCamelCase(method.Name.Value) {
-		pass.reportInvalidNode(method, MessageInvalidDeclarationName)
+		pass.reportInvalidNode(method, CodeInvalidDeclarationName)
 	}
 	pass.ensureExplicitParameterNamingOnDuplicateTypes(method.Parameters)
 }
 
 func (pass *NamingCheckPass) ensureExplicitParameterNamingOnDuplicateTypes(parameters tree.ParameterList) {
-	parameterTypeNames := map[string]bool{}
+	parameterTypeCounts := map[string]int{}
 	for _, parameter := range parameters {
-		if parameterTypeNames[parameter.Type.BaseName()] {
-			pass.reportInvalidNode(parameter, MessageImplicitParameterName)
+		parameterTypeCounts[parameter.Type.BaseName()]++
+	}
+	for _, parameter := range parameters {
+		typeName := parameter.Type.BaseName()
+		if parameterTypeCounts[typeName] > 1 && isNamedImplicitly(parameter) {
+			pass.reportInvalidNode(parameter, CodeImplicitParameterName)
 		}
-		parameterTypeNames[parameter.Type.BaseName()] = true
 	}
 }
 
+func isNamedImplicitly(parameter *tree.Parameter) bool {
+	return parameter.Name.Value == convertToLowerCamelCase(parameter.Type.BaseName())
+}
+
 // checkParameterNaming ensures that a parameter is named lowerCamelCase.
 func (pass *NamingCheckPass) checkParameterNaming(parameter *tree.Parameter) {
 	if isLowerCamelCase(parameter.Name.Value) {
 		return
 	}
-	pass.reportInvalidNode(parameter, MessageInvalidDeclarationName)
+	pass.reportInvalidNode(parameter, CodeInvalidDeclarationName)
 }
 
 func isCharLowerCase(char uint8) bool {
@@ -0,0 +1,115 @@
+package semantic
+
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+)
+
+func findFixWithTitle(entries []diagnostic.Entry, title string) (diagnostic.PositionedFix, bool) {
+	for _, entry := range entries {
+		for _, fix := range entry.Fixes {
+			if fix.Title == title {
+				return fix, true
+			}
+		}
+	}
+	return diagnostic.PositionedFix{}, false
+}
+
+func TestNamingCheckPass_RenamesLocalDeclarations(testing *testing.T) {
+	code := `
+method Run(values Number[])
+  let Total_Count = 0
+  for Value in values
+    Total_Count = Total_Count + Value
+`
+	entries := runPass(testing, NamingCheckPassId, code)
+	expectedFixes := map[string]int{
+		"Rename Total_Count to totalCount": 3,
+		"Rename Value to value":            2,
+	}
+	for title, editCount := range expectedFixes {
+		fix, ok := findFixWithTitle(entries, title)
+		if !ok {
+			testing.Errorf("expected fix %q", title)
+			continue
+		}
+		if !fix.MachineApplicable {
+			testing.Errorf("expected fix %q to be machine-applicable", title)
+		}
+		if len(fix.Edits) != editCount {
+			testing.Errorf("expected fix %q to have %d edits, but got %d",
+				title, editCount, len(fix.Edits))
+		}
+		for _, edit := range fix.Edits {
+			renamed := code[edit.Position.Begin.Offset:edit.Position.End.Offset]
+			if convertToLowerCamelCase(renamed) != edit.Replacement {
+				testing.Errorf("fix %q replaces %q", title, renamed)
+			}
+		}
+	}
+	if !containsMessage(entries, diagnostic.FormatMessage(CodeInvalidDeclarationName)) {
+		testing.Error("expected invalid declaration names to be reported")
+	}
+}
+
+func TestNamingCheckPass_AcceptsExportedFields(testing *testing.T) {
+	entries := runPass(testing, NamingCheckPassId, `
+has Limit Number
+has TOTAL Number
+
+method run()
+  has Local Number
+`)
+	reportedLines := map[input.LineIndex]bool{}
+	for _, entry := range entries {
+		if entry.Code == CodeInvalidDeclarationName {
+			reportedLines[entry.Position.Begin.Line.Index] = true
+		}
+	}
+	expected := map[input.LineIndex]bool{2: false, 3: true, 6: true}
+	for line, reported := range expected {
+		if reportedLines[line] != reported {
+			testing.Errorf("expected the name on line %d to be reported: %v", line, reported)
+		}
+	}
+}
+
+func TestNamingCheckPass_ReportsImplicitParameterNamesOfRepeatedTypes(testing *testing.T) {
+	entries := runPass(testing, NamingCheckPassId, `
+method add(left Number, right Number) returns Number
+  return left + right
+
+method scale(number Number, factor Number) returns Number
+  return number * factor
+
+method describe(number Number, text String) returns String
+  return text
+`)
+	var reported []diagnostic.Entry
+	for _, entry := range entries {
+		if entry.Code == CodeImplicitParameterName {
+			reported = append(reported, entry)
+		}
+	}
+	if len(reported) != 1 || reported[0].Position.Begin.Line.Index != 5 {
+		testing.Errorf("expected only the parameter number of scale to be reported, got %+v",
+			reported)
+	}
+}
+
+func TestConvertToLowerCamelCase(testing *testing.T) {
+	entries := map[string]string{
+		"UpperCamelCase": "upperCamelCase",
+		"snake_case":     "snakeCase",
+		"Single":         "single",
+		"lowerCamelCase": "lowerCamelCase",
+	}
+	for name, expected := range entries {
+		if converted := convertToLowerCamelCase(name); converted != expected {
+			testing.Errorf("converted %s to %s, expected %s", name, converted, expected)
+		}
+	}
+}
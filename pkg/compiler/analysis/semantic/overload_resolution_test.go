@@ -0,0 +1,108 @@
+package semantic
+
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+)
+
+func TestNameResolutionPass_SelectsOverloadByLabelAndClass(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+method Add(left Number, right Number) returns Number
+  return left + right
+
+method Add(left Float, right Float) returns Float
+  return left + right
+
+method Scale(value Number) returns Number
+  return value
+
+method Scale(factor Float) returns Float
+  return factor
+
+method Run()
+  has sum Number
+  sum = Add(1, 2)
+  has fraction Float
+  fraction = Add(1.5, 2.5)
+  sum = Scale(value = 2)
+  fraction = Scale(factor = 2)
+`)
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
+
+func TestNameResolutionPass_ReportsAmbiguousAndUnmatchedCalls(testing *testing.T) {
+	entries := runPass(testing, NameResolutionPassId, `
+method Pick(left Number) returns Number
+  return left
+
+method Pick(right Number) returns Number
+  return right
+
+method Run()
+  Pick(1)
+  Pick(other = 1)
+  Pick(left = 1)
+`)
+	expectedMessages := []string{
+		diagnostic.FormatMessage(CodeAmbiguousCall, "Pick", 2),
+		diagnostic.FormatMessage(CodeNoMatchingOverload, "Pick"),
+	}
+	for _, message := range expectedMessages {
+		if !containsMessage(entries, message) {
+			testing.Errorf("expected diagnostic %q", message)
+		}
+	}
+	if len(entries) != len(expectedMessages) {
+		testing.Errorf("expected %d diagnostics, got %d",
+			len(expectedMessages), len(entries))
+	}
+}
+
+func TestNameResolutionPass_RejectsDuplicateLabelsOfOverloadedCalls(testing *testing.T) {
+	entries := runPass(testing, NameResolutionPassId, `
+method Move(x Number, y Number) returns Number
+  return x + y
+
+method Move(x Float, y Float) returns Float
+  return x + y
+
+method Run()
+  Move(x = 1, x = 2)
+`)
+	if !containsMessage(entries, diagnostic.FormatMessage(CodeNoMatchingOverload, "Move")) {
+		testing.Errorf("expected the call with duplicate labels to be rejected, got %+v", entries)
+	}
+}
+
+func TestSymbolEnterPass_ReportsOverloadsWithSameSignature(testing *testing.T) {
+	entries := runPass(testing, NameResolutionPassId, `
+method Pick(left Number) returns Number
+  return left
+
+method Pick(left Number) returns Float
+  return 1.5
+`)
+	if !containsMessage(entries, "collision for name Pick") {
+		testing.Error("expected a collision of the overloads")
+	}
+}
+
+func TestSymbolEnterPass_LabelsFirstDeclaration(testing *testing.T) {
+	entries := runPass(testing, NameResolutionPassId, `
+method run()
+  let value = 1
+  let value = 2
+`)
+	if len(entries) != 1 || len(entries[0].Labels) != 1 {
+		testing.Fatalf("expected one labeled collision, got %+v", entries)
+	}
+	label := entries[0].Labels[0]
+	begin := label.Position.Begin
+	if label.Message != "first declared here" || begin.Line.Index != 3 || begin.Column != 6 {
+		testing.Errorf("unexpected label %q at %d:%d",
+			label.Message, begin.Line.Index, begin.Column)
+	}
+}
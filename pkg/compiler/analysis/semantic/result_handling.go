@@ -0,0 +1,117 @@
+package semantic
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+const (
+	CodeIgnoredResult                    diagnostic.Code = "S0401"
+	CodePropagationOutsideOfResultMethod diagnostic.Code = "S0402"
+	CodePropagationOfNonResult           diagnostic.Code = "S0403"
+	CodeErrorOutsideOfReturn             diagnostic.Code = "S0404"
+)
+
+const ResultHandlingPassId = "ResultHandlingPass"
+
+func init() {
+	passes.Register(&ResultHandlingPass{})
+}
+
+// ResultHandlingPass ensures that failures, which are returned by methods in
+// the form of a Result, are never silently dropped. Callers have to either
+// handle the Result, by storing and checking it, or propagate its failure
+// to their own caller using the '?' operator.
+type ResultHandlingPass struct {
+	context *passes.Context
+}
+
+func (pass *ResultHandlingPass) Run(context *passes.Context) {
+	pass.context = context
+	context.Unit.AcceptRecursive(pass.createVisitor())
+}
+
+func (pass *ResultHandlingPass) Dependencies(isolate *isolate.Isolate) passes.Set {
+	return passes.ListInIsolate(isolate, NameResolutionPassId)
+}
+
+func (pass *ResultHandlingPass) Id() passes.Id {
+	return ResultHandlingPassId
+}
+
+func (pass *ResultHandlingPass) createVisitor() tree.Visitor {
+	visitor := tree.NewEmptyVisitor()
+	visitor.ExpressionStatementVisitor = pass.checkExpressionStatement
+	visitor.PropagationExpressionVisitor = pass.checkPropagation
+	visitor.CallExpressionVisitor = pass.checkErrorCreation
+	return visitor
+}
+
+// checkExpressionStatement ensures that the value of an expression statement
+// is not a Result. Its value is discarded and the failure would be lost.
+// Let bindings are also expression statements but store their value.
+func (pass *ResultHandlingPass) checkExpressionStatement(
+	statement *tree.ExpressionStatement) {
+
+	if _, isBinding := statement.Expression.(*tree.LetBinding); isBinding {
+		return
+	}
+	if class, ok := statement.Expression.ResolvedType(); ok && scope.IsResultClass(class) {
+		pass.reportInvalidNode(statement, CodeIgnoredResult)
+	}
+}
+
+func (pass *ResultHandlingPass) checkPropagation(
+	propagation *tree.PropagationExpression) {
+
+	if !isInsideOfResultMethod(propagation) {
+		pass.reportInvalidNode(propagation, CodePropagationOutsideOfResultMethod)
+	}
+	class, ok := propagation.Operand.ResolvedType()
+	if ok && !scope.IsResultClass(class) {
+		pass.reportInvalidNode(propagation, CodePropagationOfNonResult)
+	}
+}
+
+// checkErrorCreation ensures that failures, which are created by calling the
+// builtin Error method, are directly returned from a method returning a Result.
+func (pass *ResultHandlingPass) checkErrorCreation(call *tree.CallExpression) {
+	if !isErrorCreation(call) {
+		return
+	}
+	if _, isReturned := call.Parent.(*tree.ReturnStatement); !isReturned ||
+		!isInsideOfResultMethod(call) {
+
+		pass.reportInvalidNode(call, CodeErrorOutsideOfReturn)
+	}
+}
+
+func (pass *ResultHandlingPass) reportInvalidNode(
+	node tree.Node, code diagnostic.Code, arguments ...interface{}) {
+
+	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+		Kind:     &diagnostic.Error,
+		Stage:    &diagnostic.SemanticAnalysis,
+		Message:  diagnostic.FormatMessage(code, arguments...),
+		Code:     code,
+		UnitName: pass.context.Unit.Name,
+		Position: node.Locate(),
+	})
+}
+
+func isErrorCreation(call *tree.CallExpression) bool {
+	if name, ok := call.TargetName(); ok && name.IsBound() {
+		return name.Binding() == scope.Builtins.Error
+	}
+	return false
+}
+
+func isInsideOfResultMethod(node tree.Node) bool {
+	if method, ok := tree.SearchEnclosingMethod(node); ok {
+		return method.ReturnsResult()
+	}
+	return false
+}
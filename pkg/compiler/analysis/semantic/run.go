@@ -1,7 +1,41 @@
 package semantic
 
-import "github.com/strict-lang/sdk/pkg/compiler/pass"
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	"github.com/strict-lang/sdk/pkg/compiler/pass"
+)
 
+// Run runs every pass of the semantic analysis on the unit of the context.
 func Run(context *pass.Context) error {
-	return pass.RunWithId(NameResolutionPassId, context)
+	return pass.RunWithId(CompletionPassId, context)
+}
+
+const CompletionPassId = "SemanticCompletionPass"
+
+func init() {
+	pass.Register(&CompletionPass{})
+}
+
+// CompletionPass does not analyse the unit itself. It depends on every pass
+// of the semantic analysis, so that running it runs the whole analysis.
+// New passes have to be added to its dependencies.
+type CompletionPass struct{}
+
+func (completion *CompletionPass) Run(context *pass.Context) {}
+
+func (completion *CompletionPass) Dependencies(isolate *isolate.Isolate) pass.Set {
+	return pass.ListInIsolate(isolate,
+		NameResolutionPassId,
+		ResultHandlingPassId,
+		UnionMatchingPassId,
+		ControlFlowPassId,
+		AssignmentCheckingPassId,
+		UnusedSymbolPassId,
+		TraitConformancePassId,
+		NamingCheckPassId,
+		TypeCheckingPassId)
+}
+
+func (completion *CompletionPass) Id() pass.Id {
+	return CompletionPassId
 }
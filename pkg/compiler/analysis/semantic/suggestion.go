@@ -0,0 +1,173 @@
+package semantic
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// maxSuggestions is the maximum number of names, that are suggested for an
+// unresolved name.
+const maxSuggestions = 3
+
+type suggestion struct {
+	name     string
+	distance int
+}
+
+// suggestSimilarNames selects the candidates, that are close to the name. The
+// closest candidates are returned first. Candidates are close if they only
+// differ in their case, are within a small edit distance or consist of the
+// same camel-case words, which may be misspelled or reordered.
+func suggestSimilarNames(name string, candidates []string) []string {
+	var suggestions []suggestion
+	for _, candidate := range candidates {
+		if candidate == name {
+			continue
+		}
+		if distance, ok := measureNameDistance(name, candidate); ok {
+			suggestions = append(suggestions, suggestion{name: candidate, distance: distance})
+		}
+	}
+	sort.Slice(suggestions, func(left, right int) bool {
+		if suggestions[left].distance != suggestions[right].distance {
+			return suggestions[left].distance < suggestions[right].distance
+		}
+		return suggestions[left].name < suggestions[right].name
+	})
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	names := make([]string, len(suggestions))
+	for index, suggestion := range suggestions {
+		names[index] = suggestion.name
+	}
+	return names
+}
+
+func measureNameDistance(name string, candidate string) (int, bool) {
+	lowerName, lowerCandidate := strings.ToLower(name), strings.ToLower(candidate)
+	if lowerName == lowerCandidate {
+		return 0, true
+	}
+	if distance := computeEditDistance(lowerName, lowerCandidate); distance <= maxEditDistance(name) {
+		return distance, true
+	}
+	return measureWordDistance(name, candidate)
+}
+
+// maxEditDistance returns the number of edits, that a candidate may be away
+// from the name. Short names have to match closely, otherwise every short
+// name would be suggested.
+func maxEditDistance(name string) int {
+	length := len([]rune(name))
+	if length < 3 {
+		return 0
+	}
+	if length < 6 {
+		return 1
+	}
+	return length / 3
+}
+
+// measureWordDistance compares the camel-case words of the names. Names are
+// close if they consist of the same words in a different order, or if each
+// of their words is at most a single edit away from the other one.
+func measureWordDistance(name string, candidate string) (int, bool) {
+	nameWords, candidateWords := splitCamelCase(name), splitCamelCase(candidate)
+	if len(nameWords) < 2 || len(nameWords) != len(candidateWords) {
+		return 0, false
+	}
+	if isPermutation(nameWords, candidateWords) {
+		return 1, true
+	}
+	distance := 0
+	for index, word := range nameWords {
+		wordDistance := computeEditDistance(word, candidateWords[index])
+		if wordDistance > 1 {
+			return 0, false
+		}
+		distance += wordDistance
+	}
+	return distance, true
+}
+
+func isPermutation(words []string, others []string) bool {
+	sortedWords := append([]string{}, words...)
+	sortedOthers := append([]string{}, others...)
+	sort.Strings(sortedWords)
+	sort.Strings(sortedOthers)
+	for index, word := range sortedWords {
+		if word != sortedOthers[index] {
+			return false
+		}
+	}
+	return true
+}
+
+// splitCamelCase splits the name into its lower-cased words. A new word
+// begins at every upper-case letter, that follows a lower-case letter or
+// digit, and at every underscore.
+func splitCamelCase(name string) (words []string) {
+	var word []rune
+	var previous rune
+	for _, character := range name {
+		isBoundary := unicode.IsUpper(character) &&
+			(unicode.IsLower(previous) || unicode.IsDigit(previous))
+		if (isBoundary || character == '_') && len(word) != 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+		if character != '_' {
+			word = append(word, unicode.ToLower(character))
+		}
+		previous = character
+	}
+	if len(word) != 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
+// computeEditDistance computes the optimal string alignment distance of the
+// names, which counts insertions, removals, replacements and transpositions
+// of adjacent characters.
+func computeEditDistance(name string, other string) int {
+	left, right := []rune(name), []rune(other)
+	distances := make([][]int, len(left)+1)
+	for row := range distances {
+		distances[row] = make([]int, len(right)+1)
+		distances[row][0] = row
+	}
+	for column := range distances[0] {
+		distances[0][column] = column
+	}
+	for row := 1; row <= len(left); row++ {
+		for column := 1; column <= len(right); column++ {
+			cost := 1
+			if left[row-1] == right[column-1] {
+				cost = 0
+			}
+			distance := minimum(
+				distances[row-1][column]+1,
+				distances[row][column-1]+1,
+				distances[row-1][column-1]+cost)
+			if row > 1 && column > 1 &&
+				left[row-1] == right[column-2] && left[row-2] == right[column-1] {
+				distance = minimum(distance, distances[row-2][column-2]+1)
+			}
+			distances[row][column] = distance
+		}
+	}
+	return distances[len(left)][len(right)]
+}
+
+func minimum(first int, others ...int) int {
+	result := first
+	for _, value := range others {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
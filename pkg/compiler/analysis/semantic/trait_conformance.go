@@ -0,0 +1,213 @@
+package semantic
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+// The details of a mismatched trait method are keyed by the code of the
+// mismatch and the difference, that they describe.
+var (
+	keyParameterCountMismatch = CodeMismatchedTraitMethod.Qualify("parameterCount")
+	keyParameterClassMismatch = CodeMismatchedTraitMethod.Qualify("parameterClass")
+	keyReturnClassMismatch    = CodeMismatchedTraitMethod.Qualify("returnClass")
+)
+
+const (
+	CodeUnknownTrait          diagnostic.Code = "S0601"
+	CodeMissingTraitMethod    diagnostic.Code = "S0602"
+	CodeMismatchedTraitMethod diagnostic.Code = "S0603"
+)
+
+const TraitConformancePassId = "TraitConformancePass"
+
+func init() {
+	passes.Register(&TraitConformancePass{})
+}
+
+// TraitConformancePass ensures that classes provide every abstract method of
+// the traits they implement, including the methods of their super-traits. Traits are implemented with `implement` or by
+// listing them as super types of the class. The implementing methods need to
+// have the same parameters as the trait methods and have to return values
+// that are assignable to the return type of the trait method. Traits may
+// be declared in the same namespace or imported from api descriptors.
+// The implemented traits are recorded by the SymbolEnterPass, this pass only
+// reads them.
+type TraitConformancePass struct {
+	context *passes.Context
+}
+
+func (pass *TraitConformancePass) Run(context *passes.Context) {
+	pass.context = context
+	visitor := tree.NewEmptyVisitor()
+	visitor.ClassDeclarationVisitor = pass.checkClass
+	context.Unit.AcceptRecursive(visitor)
+}
+
+func (pass *TraitConformancePass) Dependencies(isolate *isolate.Isolate) passes.Set {
+	return passes.ListInIsolate(isolate, NameResolutionPassId)
+}
+
+func (pass *TraitConformancePass) Id() passes.Id {
+	return TraitConformancePassId
+}
+
+// implementedTrait is the name of an implemented trait and the node that
+// declares the implementation. Diagnostics are reported at the node.
+type implementedTrait struct {
+	name tree.TypeName
+	node tree.Node
+}
+
+func listImplementedTraits(declaration *tree.ClassDeclaration) []implementedTrait {
+	var traits []implementedTrait
+	for _, superType := range declaration.SuperTypes {
+		traits = append(traits, implementedTrait{name: superType, node: superType})
+	}
+	for _, child := range declaration.Children {
+		if statement, ok := child.(*tree.ImplementStatement); ok {
+			traits = append(traits, implementedTrait{name: statement.Trait, node: statement})
+		}
+	}
+	return traits
+}
+
+// checkClass checks the traits of the class. Traits themselves are not
+// checked, since they do not have to implement the methods of their traits.
+func (pass *TraitConformancePass) checkClass(declaration *tree.ClassDeclaration) {
+	if declaration.Trait || declaration.Scope() == nil {
+		return
+	}
+	methods := collectDeclaredMethods(declaration)
+	for _, implemented := range listImplementedTraits(declaration) {
+		point := scope.NewReferencePoint(implemented.name.BaseName())
+		trait, ok := scope.LookupClass(declaration.Scope(), point)
+		if !ok {
+			pass.reportInvalidNode(implemented.node, CodeUnknownTrait,
+				implemented.name.FullName())
+			continue
+		}
+		pass.checkConformance(declaration, methods, trait, implemented.node)
+	}
+}
+
+// collectDeclaredMethods maps the names of the classes methods to their
+// declarations. A name is mapped to multiple declarations, if the method is
+// overloaded. Extension methods are not members of the class.
+func collectDeclaredMethods(
+	declaration *tree.ClassDeclaration) map[string][]*tree.MethodDeclaration {
+
+	methods := map[string][]*tree.MethodDeclaration{}
+	for _, child := range declaration.Children {
+		if method, ok := child.(*tree.MethodDeclaration); ok && !method.IsExtension() {
+			methods[method.Name.Value] = append(methods[method.Name.Value], method)
+		}
+	}
+	return methods
+}
+
+// selectImplementation selects the overload, that implements the required
+// method. If no overload has the same signature, the first one is selected
+// and its mismatch is reported.
+func selectImplementation(
+	overloads []*tree.MethodDeclaration,
+	required *scope.Method) (*tree.MethodDeclaration, bool) {
+
+	for _, overload := range overloads {
+		symbol, ok := scope.AsMethodSymbol(overload.Name.Binding())
+		if ok && scope.HasSameSignature(symbol, required) {
+			return overload, true
+		}
+	}
+	if len(overloads) == 0 {
+		return nil, false
+	}
+	return overloads[0], true
+}
+
+func (pass *TraitConformancePass) checkConformance(
+	declaration *tree.ClassDeclaration,
+	methods map[string][]*tree.MethodDeclaration,
+	trait *scope.Class,
+	node tree.Node) {
+
+	for _, required := range scope.ListAbstractMethods(trait) {
+		method, ok := selectImplementation(methods[required.Name()], required)
+		if !ok {
+			pass.reportInvalidNode(node, CodeMissingTraitMethod,
+				declaration.Name, required.Name(), trait.Name())
+			continue
+		}
+		if symbol, ok := scope.AsMethodSymbol(method.Name.Binding()); ok {
+			pass.checkSignature(method, symbol, required, trait)
+		}
+	}
+}
+
+func (pass *TraitConformancePass) checkSignature(
+	method *tree.MethodDeclaration,
+	implemented *scope.Method,
+	required *scope.Method,
+	trait *scope.Class) {
+
+	if mismatch, ok := findSignatureMismatch(implemented, required); ok {
+		pass.reportInvalidNode(method.Name, CodeMismatchedTraitMethod,
+			method.Name.Value, trait.Name(), mismatch)
+	}
+}
+
+// findSignatureMismatch compares the signature of the implemented method with
+// the one of the required method and describes the first difference.
+func findSignatureMismatch(
+	implemented *scope.Method, required *scope.Method) (string, bool) {
+
+	if len(implemented.Parameters) != len(required.Parameters) {
+		return diagnostic.FormatMessage(keyParameterCountMismatch,
+			len(required.Parameters), len(implemented.Parameters)), true
+	}
+	for index, parameter := range implemented.Parameters {
+		expected := required.Parameters[index]
+		if parameter == nil || expected == nil {
+			continue
+		}
+		if !isSameClass(parameter.Class, expected.Class) {
+			return diagnostic.FormatMessage(keyParameterClassMismatch, parameter.Name(),
+				nameOfClass(expected.Class), nameOfClass(parameter.Class)), true
+		}
+	}
+	if !isReturnTypeCompatible(implemented.ReturnType, required.ReturnType) {
+		return diagnostic.FormatMessage(keyReturnClassMismatch,
+			nameOfClass(required.ReturnType), nameOfClass(implemented.ReturnType)), true
+	}
+	return "", false
+}
+
+func isSameClass(left *scope.Class, right *scope.Class) bool {
+	if left == nil || right == nil {
+		return true
+	}
+	return scope.IsAssignable(left, right) && scope.IsAssignable(right, left)
+}
+
+func isReturnTypeCompatible(implemented *scope.Class, required *scope.Class) bool {
+	if implemented == nil || required == nil {
+		return true
+	}
+	return scope.IsAssignable(implemented, required)
+}
+
+func (pass *TraitConformancePass) reportInvalidNode(
+	node tree.Node, code diagnostic.Code, arguments ...interface{}) {
+
+	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+		Kind:     &diagnostic.Error,
+		Stage:    &diagnostic.SemanticAnalysis,
+		Message:  diagnostic.FormatMessage(code, arguments...),
+		Code:     code,
+		UnitName: pass.context.Unit.Name,
+		Position: node.Locate(),
+	})
+}
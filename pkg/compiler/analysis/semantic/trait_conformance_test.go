@@ -0,0 +1,128 @@
+package semantic
+
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+	"github.com/strict-lang/sdk/pkg/compiler/typing"
+)
+
+func createTraitImportScope() scope.Scope {
+	return createTraitImportScopeOfUnit("Test", "Run", "Name")
+}
+
+// createTraitImportScopeOfUnit creates an import scope with the trait App,
+// whose methods are named by the passed names.
+func createTraitImportScopeOfUnit(
+	unitName string, runMethod string, nameMethod string) scope.Scope {
+
+	importScope := createImportScopeOfUnit(unitName).(scope.MutableScope)
+	app := &scope.Class{
+		DeclarationName: "App",
+		QualifiedName:   "App",
+		ActualClass:     typing.NewEmptyClass("App"),
+	}
+	app.Scope = scope.NewOuterScope("App", importScope)
+	app.Scope.Insert(&scope.Method{
+		DeclarationName: runMethod,
+		ReturnType:      scope.Builtins.Void,
+		EnclosingClass:  app,
+		Abstract:        true,
+	})
+	app.Scope.Insert(&scope.Method{
+		DeclarationName: nameMethod,
+		ReturnType:      scope.Builtins.String,
+		Parameters: []*scope.Field{
+			{DeclarationName: "short", Class: scope.Builtins.Boolean},
+		},
+		EnclosingClass: app,
+		Abstract:       true,
+	})
+	importScope.Insert(app)
+	return importScope
+}
+
+func TestTraitConformancePass_AcceptsConformingClass(testing *testing.T) {
+	entries := runPassInScope(testing, TraitConformancePassId, `
+implement App
+
+method Run()
+  Name(True)
+
+method Name(short Boolean) returns String
+  return "Test"
+`, createTraitImportScope())
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
+
+func TestTraitConformancePass_ReportsMissingAndMismatchedMethods(testing *testing.T) {
+	entries := runPassInScope(testing, TraitConformancePassId, `
+implement App
+implement Unknown
+
+method Name(short Number) returns String
+  return "Test"
+`, createTraitImportScope())
+	expectedMessages := []string{
+		"The method Name does not match the method of the trait App, its" +
+			" parameter short has to be of Boolean instead of Number",
+		"The class Test does not implement the method Run of the trait App",
+		"The implemented trait Unknown does not exist",
+	}
+	if len(entries) != len(expectedMessages) {
+		testing.Errorf("expected %d diagnostics but got %d",
+			len(expectedMessages), len(entries))
+	}
+	for _, expected := range expectedMessages {
+		if !containsMessage(entries, expected) {
+			testing.Errorf("expected diagnostic %q to be reported", expected)
+		}
+	}
+}
+
+func TestTraitConformancePass_ChecksMethodsOfSuperTraits(testing *testing.T) {
+	importScope := createTraitImportScope().(scope.MutableScope)
+	app, _ := scope.LookupClass(importScope, scope.NewReferencePoint("App"))
+	service := &scope.Class{
+		DeclarationName: "Service",
+		QualifiedName:   "Service",
+		ActualClass:     typing.NewEmptyClass("Service"),
+	}
+	service.Scope = scope.NewOuterScope("Service", importScope)
+	scope.AddTrait(service, app)
+	importScope.Insert(service)
+
+	entries := runPassInScope(testing, TraitConformancePassId, `
+implement Service
+
+method Name(short Boolean) returns String
+  return "Test"
+`, importScope)
+	expected := "The class Test does not implement the method Run of the trait Service"
+	if len(entries) != 1 || !containsMessage(entries, expected) {
+		testing.Errorf("expected only diagnostic %q to be reported", expected)
+	}
+}
+
+func TestTypeCheckingPass_AcceptsClassAsImplementedTrait(testing *testing.T) {
+	entries := runPassInScope(testing, TypeCheckingPassId, `
+implement App
+
+method Start(app App)
+  app.Run()
+
+method Restart(other Test)
+  Start(other)
+
+method Run()
+  Name(True)
+
+method Name(short Boolean) returns String
+  return "Test"
+`, createTraitImportScope())
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
@@ -0,0 +1,371 @@
+package semantic
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/token"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+const (
+	CodeInvalidOperands      diagnostic.Code = "S0201"
+	CodeInvalidOperand       diagnostic.Code = "S0202"
+	CodeInvalidAssign        diagnostic.Code = "S0203"
+	CodeArgumentCount        diagnostic.Code = "S0204"
+	CodeUnknownLabel         diagnostic.Code = "S0205"
+	CodeInvalidArgument      diagnostic.Code = "S0206"
+	CodeNonBooleanCondition  diagnostic.Code = "S0207"
+	CodeInvalidReturn        diagnostic.Code = "S0208"
+	CodeUnsatisfiedBound     diagnostic.Code = "S0209"
+	CodeYieldOutsideOfMethod diagnostic.Code = "S0210"
+	CodeYieldingNonList      diagnostic.Code = "S0211"
+	CodeInvalidYield         diagnostic.Code = "S0212"
+	CodeDuplicateArgument    diagnostic.Code = "S0213"
+)
+
+const TypeCheckingPassId = "TypeCheckingPass"
+
+func init() {
+	passes.Register(&TypeCheckingPass{})
+}
+
+// TypeCheckingPass checks that the classes, which are resolved by the
+// NameResolutionPass, are used correctly. It checks the operands of
+// operators, assigned and returned values, the arguments of calls and
+// the conditions of conditional statements. Expressions of the class
+// Any are accepted everywhere, since they failed to resolve and have
+// already been reported. Generic classes have to be instantiated with
+// arguments, that implement the bounds of their type parameters. Methods
+// that yield values have to return a list of the yielded values.
+type TypeCheckingPass struct {
+	context *passes.Context
+}
+
+func (pass *TypeCheckingPass) Run(context *passes.Context) {
+	pass.context = context
+	context.Unit.AcceptRecursive(pass.createVisitor())
+}
+
+func (pass *TypeCheckingPass) Dependencies(isolate *isolate.Isolate) passes.Set {
+	return passes.ListInIsolate(isolate, NameResolutionPassId, TraitConformancePassId)
+}
+
+func (pass *TypeCheckingPass) Id() passes.Id {
+	return TypeCheckingPassId
+}
+
+func (pass *TypeCheckingPass) createVisitor() tree.Visitor {
+	visitor := tree.NewEmptyVisitor()
+	visitor.BinaryExpressionVisitor = pass.checkBinaryExpression
+	visitor.UnaryExpressionVisitor = pass.checkUnaryExpression
+	visitor.AssignStatementVisitor = pass.checkAssignStatement
+	visitor.CallExpressionVisitor = pass.checkCallExpression
+	visitor.ConditionalStatementVisitor = pass.checkConditionalStatement
+	visitor.ReturnStatementVisitor = pass.checkReturnStatement
+	visitor.YieldStatementVisitor = pass.checkYieldStatement
+	visitor.GenericTypeNameVisitor = pass.checkGenericTypeName
+	return visitor
+}
+
+// checkGenericTypeName checks that the arguments of an instantiated generic
+// class implement the bounds of its type parameters.
+func (pass *TypeCheckingPass) checkGenericTypeName(name *tree.GenericTypeName) {
+	nearestScope, ok := tree.ResolveNearestScope(name)
+	if !ok {
+		return
+	}
+	generic, ok := scope.LookupClass(nearestScope, scope.NewReferencePoint(name.Name))
+	if !ok {
+		return
+	}
+	arguments := make([]*scope.Class, len(name.Arguments))
+	for index, argument := range name.Arguments {
+		point := scope.NewReferencePoint(argument.Name)
+		if arguments[index], ok = scope.LookupClass(nearestScope, point); !ok {
+			return
+		}
+	}
+	if index, ok := scope.FindUnsatisfiedBound(generic, arguments); ok {
+		parameter := generic.Parameters[index]
+		bound, _ := scope.BoundOf(parameter)
+		pass.reportInvalidNode(name, CodeUnsatisfiedBound,
+			nameOfClass(arguments[index]), bound, parameter.Name())
+	}
+}
+
+func (pass *TypeCheckingPass) checkBinaryExpression(binary *tree.BinaryExpression) {
+	left, leftOk := binary.LeftOperand.ResolvedType()
+	right, rightOk := binary.RightOperand.ResolvedType()
+	if leftOk && rightOk {
+		pass.checkOperands(binary, binary.Operator, left, right)
+	}
+}
+
+func (pass *TypeCheckingPass) checkOperands(
+	node tree.Node, operator token.Operator, left *scope.Class, right *scope.Class) {
+
+	if left == scope.Builtins.Any || right == scope.Builtins.Any {
+		return
+	}
+	if check, ok := operandChecks[operator]; ok && !check(left, right) {
+		pass.reportInvalidNode(node, CodeInvalidOperands,
+			operator, nameOfClass(left), nameOfClass(right))
+	}
+}
+
+type operandCheck func(left *scope.Class, right *scope.Class) bool
+
+func areNumeric(left *scope.Class, right *scope.Class) bool {
+	return scope.IsNumericClass(left) && scope.IsNumericClass(right)
+}
+
+func areNumericOrStrings(left *scope.Class, right *scope.Class) bool {
+	if left == scope.Builtins.String && right == scope.Builtins.String {
+		return true
+	}
+	return areNumeric(left, right)
+}
+
+func areBooleans(left *scope.Class, right *scope.Class) bool {
+	return left == scope.Builtins.Boolean && right == scope.Builtins.Boolean
+}
+
+func areComparable(left *scope.Class, right *scope.Class) bool {
+	return scope.IsAssignable(left, right) || scope.IsAssignable(right, left)
+}
+
+var operandChecks = map[token.Operator]operandCheck{
+	token.AddOperator:           areNumericOrStrings,
+	token.SubOperator:           areNumeric,
+	token.MulOperator:           areNumeric,
+	token.DivOperator:           areNumeric,
+	token.ModOperator:           areNumeric,
+	token.SmallerOperator:       areNumeric,
+	token.GreaterOperator:       areNumeric,
+	token.SmallerEqualsOperator: areNumeric,
+	token.GreaterEqualsOperator: areNumeric,
+	token.EqualsOperator:        areComparable,
+	token.NotEqualsOperator:     areComparable,
+	token.AndOperator:           areBooleans,
+	token.OrOperator:            areBooleans,
+	token.XorOperator:           areBooleans,
+}
+
+// assignOperations maps the operators of compound assignments to the binary
+// operators, that are applied to the target and the value.
+var assignOperations = map[token.Operator]token.Operator{
+	token.AddAssignOperator: token.AddOperator,
+	token.SubAssignOperator: token.SubOperator,
+	token.MulAssignOperator: token.MulOperator,
+	token.DivAssignOperator: token.DivOperator,
+}
+
+func (pass *TypeCheckingPass) checkUnaryExpression(unary *tree.UnaryExpression) {
+	operand, ok := unary.Operand.ResolvedType()
+	if !ok || operand == scope.Builtins.Any {
+		return
+	}
+	if !isValidUnaryOperand(unary.Operator, operand) {
+		pass.reportInvalidNode(unary, CodeInvalidOperand,
+			unary.Operator, nameOfClass(operand))
+	}
+}
+
+func isValidUnaryOperand(operator token.Operator, operand *scope.Class) bool {
+	if operator == token.NegateOperator {
+		return operand == scope.Builtins.Boolean
+	}
+	return scope.IsNumericClass(operand)
+}
+
+func (pass *TypeCheckingPass) checkAssignStatement(assign *tree.AssignStatement) {
+	target, targetOk := resolveAssignTarget(assign.Target)
+	value, valueOk := assign.Value.ResolvedType()
+	if !targetOk || !valueOk {
+		return
+	}
+	if operator, ok := assignOperations[assign.Operator]; ok {
+		pass.checkOperands(assign, operator, target, value)
+		return
+	}
+	if !scope.IsAssignable(value, target) {
+		pass.reportInvalidNode(assign.Value, CodeInvalidAssign,
+			nameOfClass(value), nameOfClass(target))
+	}
+}
+
+// resolveAssignTarget returns the class of the assigned target. Variables,
+// that are defined by the assignment, are typed by their declaration.
+func resolveAssignTarget(target tree.Node) (*scope.Class, bool) {
+	switch target := target.(type) {
+	case *tree.FieldDeclaration:
+		return target.Name.ResolvedType()
+	case tree.Expression:
+		return target.ResolvedType()
+	}
+	return nil, false
+}
+
+func (pass *TypeCheckingPass) checkCallExpression(call *tree.CallExpression) {
+	name, ok := call.TargetName()
+	if !ok {
+		return
+	}
+	if method, ok := scope.AsMethodSymbol(name.Binding()); ok {
+		pass.checkArguments(call, method)
+	}
+}
+
+func (pass *TypeCheckingPass) checkArguments(
+	call *tree.CallExpression, method *scope.Method) {
+
+	if len(call.Arguments) != len(method.Parameters) {
+		pass.reportInvalidNode(call, CodeArgumentCount,
+			method.Name(), len(method.Parameters), len(call.Arguments))
+		return
+	}
+	passed := map[*scope.Field]bool{}
+	for index, argument := range call.Arguments {
+		parameter, ok := pass.selectParameter(method, argument, index)
+		if !ok {
+			continue
+		}
+		if passed[parameter] {
+			pass.reportInvalidNode(argument, CodeDuplicateArgument,
+				parameter.Name(), method.Name())
+			continue
+		}
+		passed[parameter] = true
+		pass.checkArgument(method, argument, parameter)
+	}
+}
+
+// selectParameter returns the parameter that the argument is passed to.
+// Labeled arguments are passed to the parameter with the same label and
+// unlabeled ones to the parameter at their position.
+func (pass *TypeCheckingPass) selectParameter(
+	method *scope.Method, argument *tree.CallArgument, index int) (*scope.Field, bool) {
+
+	if !argument.IsLabeled() {
+		parameter := method.Parameters[index]
+		return parameter, parameter != nil
+	}
+	for _, parameter := range method.Parameters {
+		if parameter != nil && parameter.ArgumentLabel() == argument.Label {
+			return parameter, true
+		}
+	}
+	pass.reportInvalidNode(argument, CodeUnknownLabel, method.Name(), argument.Label)
+	return nil, false
+}
+
+func (pass *TypeCheckingPass) checkArgument(
+	method *scope.Method, argument *tree.CallArgument, parameter *scope.Field) {
+
+	value, ok := argument.Value.ResolvedType()
+	if !ok || parameter.Class == nil {
+		return
+	}
+	if !scope.IsAssignable(value, parameter.Class) {
+		pass.reportInvalidNode(argument, CodeInvalidArgument,
+			parameter.Name(), method.Name(), nameOfClass(parameter.Class), nameOfClass(value))
+	}
+}
+
+func (pass *TypeCheckingPass) checkConditionalStatement(
+	conditional *tree.ConditionalStatement) {
+
+	condition, ok := conditional.Condition.ResolvedType()
+	if ok && !scope.IsAssignable(condition, scope.Builtins.Boolean) {
+		pass.reportInvalidNode(conditional.Condition, CodeNonBooleanCondition,
+			nameOfClass(condition))
+	}
+}
+
+// checkReturnStatement checks the returned value against the return type of
+// the enclosing method. Methods that return a Result can either return the
+// Result itself or the value that it holds.
+func (pass *TypeCheckingPass) checkReturnStatement(statement *tree.ReturnStatement) {
+	if statement.Value == nil {
+		return
+	}
+	method, ok := resolveEnclosingMethodSymbol(statement)
+	value, valueOk := statement.Value.ResolvedType()
+	if !ok || !valueOk || method.ReturnType == nil {
+		return
+	}
+	if !isReturnable(value, method.ReturnType) {
+		pass.reportInvalidNode(statement.Value, CodeInvalidReturn,
+			nameOfClass(value), method.Name(), nameOfClass(method.ReturnType))
+	}
+}
+
+func isReturnable(value *scope.Class, returnType *scope.Class) bool {
+	if scope.IsAssignable(value, returnType) {
+		return true
+	}
+	return scope.IsResultClass(returnType) &&
+		scope.IsAssignable(value, scope.ResultValueClass(returnType))
+}
+
+// checkYieldStatement checks that the value is yielded inside of a method,
+// which returns a list of the yielded values.
+func (pass *TypeCheckingPass) checkYieldStatement(statement *tree.YieldStatement) {
+	if _, ok := tree.SearchEnclosingMethod(statement); !ok {
+		pass.reportInvalidNode(statement, CodeYieldOutsideOfMethod)
+		return
+	}
+	method, ok := resolveEnclosingMethodSymbol(statement)
+	if !ok || method.ReturnType == scope.Builtins.Any {
+		return
+	}
+	if method.ReturnType == nil || !scope.IsListClass(method.ReturnType) {
+		pass.reportInvalidNode(statement, CodeYieldingNonList,
+			method.Name(), nameOfReturnType(method))
+		return
+	}
+	value, ok := statement.Value.ResolvedType()
+	element := scope.ElementClass(method.ReturnType)
+	if ok && !scope.IsAssignable(value, element) {
+		pass.reportInvalidNode(statement.Value, CodeInvalidYield,
+			nameOfClass(value), method.Name(), nameOfClass(element))
+	}
+}
+
+func nameOfReturnType(method *scope.Method) string {
+	if method.ReturnType == nil {
+		return scope.Builtins.Void.Name()
+	}
+	return nameOfClass(method.ReturnType)
+}
+
+func resolveEnclosingMethodSymbol(node tree.Node) (*scope.Method, bool) {
+	if declaration, ok := tree.SearchEnclosingMethod(node); ok {
+		return scope.AsMethodSymbol(declaration.Name.Binding())
+	}
+	return nil, false
+}
+
+// nameOfClass returns the name of the class as it is written in the source,
+// including the arguments of generic classes.
+func nameOfClass(class *scope.Class) string {
+	if class.ActualClass != nil {
+		return class.ActualClass.String()
+	}
+	return class.Name()
+}
+
+func (pass *TypeCheckingPass) reportInvalidNode(
+	node tree.Node, code diagnostic.Code, arguments ...interface{}) {
+
+	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+		Kind:     &diagnostic.Error,
+		Stage:    &diagnostic.SemanticAnalysis,
+		Message:  diagnostic.FormatMessage(code, arguments...),
+		Code:     code,
+		UnitName: pass.context.Unit.Name,
+		Position: node.Locate(),
+	})
+}
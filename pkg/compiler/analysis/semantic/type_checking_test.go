@@ -0,0 +1,213 @@
+package semantic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/analysis"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis/entering"
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/syntax"
+	isolates "github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+func runPass(testing *testing.T, id passes.Id, code string) []diagnostic.Entry {
+	return runPassInScope(testing, id, code, createImportScope())
+}
+
+func runPassInScope(
+	testing *testing.T,
+	id passes.Id,
+	code string,
+	importScope scope.Scope) []diagnostic.Entry {
+
+	return runPassOnUnit(testing, id, "Test", code, importScope)
+}
+
+func runPassOnUnit(
+	testing *testing.T,
+	id passes.Id,
+	unitName string,
+	code string,
+	importScope scope.Scope) []diagnostic.Entry {
+
+	result := syntax.ParseString(unitName, code)
+	if result.Error != nil {
+		testing.Fatalf("failed to parse Unit: %v", result.Error)
+	}
+	isolate := isolates.New()
+	testAnalysis := analysis.Analysis{
+		ImportScope:    importScope,
+		NamespaceScope: importScope,
+	}
+	testAnalysis.Store(isolate)
+	context := &passes.Context{
+		Unit:       result.TranslationUnit,
+		Diagnostic: diagnostic.NewBag(),
+		Isolate:    isolate,
+	}
+	if err := entering.Run(context); err != nil {
+		testing.Fatal(err)
+	}
+	if err := passes.RunWithId(id, context); err != nil {
+		testing.Fatal(err)
+	}
+	converter := diagnostic.ConvertWithLineMap(result.LineMap)
+	return context.Diagnostic.CreateDiagnostics(converter).ListEntries()
+}
+
+func TestTypeCheckingPass_AcceptsValidCode(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+method add(left Number, right Number) returns Number
+  return left + right
+
+method greet(name String) returns String
+  if name == "" and True
+    return "Hello"
+  return "Hello " + name
+
+method run()
+  has total Number
+  has ratio Float
+  total = add(1, right = 2)
+  total += 3
+  ratio = total
+  greet("World")
+`)
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
+
+func TestTypeCheckingPass_ReportsInvalidCode(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+method add(left Number, right Number) returns Number
+  return "sum"
+
+method run()
+  has total Number
+  total = "text"
+  add(1)
+  add(1, "two")
+  add(1, other = 2)
+  add(left = 1, left = 2)
+  if total
+    total = total - "one"
+`)
+	expectedMessages := []string{
+		"A value of String can not be returned from the method add",
+		"A value of String can not be assigned to Number",
+		"The method add expects 2 arguments, but 1 are passed",
+		"The parameter right of the method add expects Number, but got String",
+		"The method add has no parameter called other",
+		"The parameter left of the method add is passed more than once",
+		"The condition has to be a Boolean, but is Number",
+		"The operator - can not be applied to Number and String",
+	}
+	if len(entries) != len(expectedMessages) {
+		testing.Errorf("expected %d diagnostics but got %d",
+			len(expectedMessages), len(entries))
+	}
+	for _, expected := range expectedMessages {
+		if !containsMessage(entries, expected) {
+			testing.Errorf("expected diagnostic %q to be reported", expected)
+		}
+	}
+}
+
+func containsMessage(entries []diagnostic.Entry, message string) bool {
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Message, message) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTypeCheckingPass_ChecksYieldingMethods(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+method Doubled(numbers Number[]) returns Number[]
+  for number in numbers
+    yield number * 2
+
+method Names() returns String[]
+  yield 1
+
+method Count() returns Number
+  yield 1
+
+method Run()
+  yield "text"
+`)
+	expectedMessages := []string{
+		"A value of Number can not be yielded from the method Names, which yields String",
+		"The method Count yields values and has to return a list, but returns Number",
+		"The method Run yields values and has to return a list, but returns Void",
+	}
+	if len(entries) != len(expectedMessages) {
+		testing.Errorf("expected %d diagnostics but got %d",
+			len(expectedMessages), len(entries))
+	}
+	for _, expected := range expectedMessages {
+		if !containsMessage(entries, expected) {
+			testing.Errorf("expected diagnostic %q to be reported", expected)
+		}
+	}
+}
+
+func TestTypeCheckingPass_ChecksArgumentCountOfBuiltinMethods(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+method run(names List<String>, ages Map<String, Number>)
+  names.Add()
+  ages.Put("Ada")
+`)
+	expectedMessages := []string{
+		"The method Add expects 1 arguments, but 0 are passed",
+		"The method Put expects 2 arguments, but 1 are passed",
+	}
+	for _, expected := range expectedMessages {
+		if !containsMessage(entries, expected) {
+			testing.Errorf("expected diagnostic %q to be reported", expected)
+		}
+	}
+}
+
+func TestTypeCheckingPass_AcceptsInferredVariables(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+method Sum(numbers List<Number>) returns Number
+  let doubled = numbers.Length() * 2
+  has total Number
+  total = 0
+  for number in numbers
+    total = total + number * 2
+  return doubled + total
+`)
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
+
+func TestTypeCheckingPass_ChecksInferredVariables(testing *testing.T) {
+	entries := runPass(testing, TypeCheckingPassId, `
+method Count(names List<String>) returns Number
+  let greeting = "Hello"
+  has count Number
+  count = greeting
+  for name in names
+    count = name
+  return count
+`)
+	expectedMessage := diagnostic.FormatMessage(CodeInvalidAssign, "String", "Number")
+	occurrences := 0
+	for _, entry := range entries {
+		if entry.Message == expectedMessage {
+			occurrences++
+		}
+	}
+	if occurrences != 2 {
+		testing.Errorf("expected %q to be reported twice, got %d",
+			expectedMessage, occurrences)
+	}
+}
@@ -0,0 +1,127 @@
+package semantic
+
+import (
+	"strings"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+const (
+	CodeMatchOfNonUnion     diagnostic.Code = "S0501"
+	CodeUnknownUnionCase    diagnostic.Code = "S0502"
+	CodeDuplicateMatchArm   diagnostic.Code = "S0503"
+	CodeMisplacedDefaultArm diagnostic.Code = "S0504"
+	CodeTooManyBindings     diagnostic.Code = "S0505"
+	CodeNonExhaustiveMatch  diagnostic.Code = "S0506"
+)
+
+const UnionMatchingPassId = "UnionMatchingPass"
+
+func init() {
+	passes.Register(&UnionMatchingPass{})
+}
+
+// UnionMatchingPass ensures that matches over unions are exhaustive. Every
+// case of the union has to be matched by exactly one arm, unless the match
+// has an else arm, which matches all remaining cases.
+type UnionMatchingPass struct {
+	context *passes.Context
+}
+
+func (pass *UnionMatchingPass) Run(context *passes.Context) {
+	pass.context = context
+	visitor := tree.NewEmptyVisitor()
+	visitor.MatchStatementVisitor = pass.checkMatch
+	context.Unit.AcceptRecursive(visitor)
+}
+
+func (pass *UnionMatchingPass) Dependencies(isolate *isolate.Isolate) passes.Set {
+	return passes.ListInIsolate(isolate, NameResolutionPassId)
+}
+
+func (pass *UnionMatchingPass) Id() passes.Id {
+	return UnionMatchingPassId
+}
+
+func (pass *UnionMatchingPass) checkMatch(match *tree.MatchStatement) {
+	union, ok := match.Subject.ResolvedType()
+	if !ok {
+		return
+	}
+	if !scope.IsUnionClass(union) {
+		pass.reportInvalidNode(match.Subject, CodeMatchOfNonUnion)
+		return
+	}
+	matched := pass.checkArms(match, union)
+	if !match.HasDefaultArm() {
+		pass.checkExhaustiveness(match, union, matched)
+	}
+}
+
+// checkArms checks every arm of the match and returns the names of the
+// cases, that are matched by the arms.
+func (pass *UnionMatchingPass) checkArms(
+	match *tree.MatchStatement, union *scope.Class) map[string]bool {
+
+	matched := map[string]bool{}
+	for index, arm := range match.Arms {
+		if arm.IsDefault() {
+			if index != len(match.Arms)-1 {
+				pass.reportInvalidNode(arm.Body, CodeMisplacedDefaultArm)
+			}
+			continue
+		}
+		name := arm.Case.Value
+		if matched[name] {
+			pass.reportInvalidNode(arm.Case, CodeDuplicateMatchArm, name)
+		}
+		matched[name] = true
+		pass.checkArm(arm, union)
+	}
+	return matched
+}
+
+func (pass *UnionMatchingPass) checkArm(arm *tree.MatchArm, union *scope.Class) {
+	constructor, ok := scope.LookupUnionCase(union, arm.Case.Value)
+	if !ok {
+		pass.reportInvalidNode(arm.Case, CodeUnknownUnionCase,
+			arm.Case.Value, union.Name())
+		return
+	}
+	if len(arm.Bindings) > len(constructor.Parameters) {
+		pass.reportInvalidNode(arm.Case, CodeTooManyBindings,
+			arm.Case.Value, len(constructor.Parameters), len(arm.Bindings))
+	}
+}
+
+func (pass *UnionMatchingPass) checkExhaustiveness(
+	match *tree.MatchStatement, union *scope.Class, matched map[string]bool) {
+
+	var missing []string
+	for _, name := range scope.UnionCaseNames(union) {
+		if !matched[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) != 0 {
+		pass.reportInvalidNode(match, CodeNonExhaustiveMatch,
+			strings.Join(missing, ", "), union.Name())
+	}
+}
+
+func (pass *UnionMatchingPass) reportInvalidNode(
+	node tree.Node, code diagnostic.Code, arguments ...interface{}) {
+
+	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+		Kind:     &diagnostic.Error,
+		Stage:    &diagnostic.SemanticAnalysis,
+		Message:  diagnostic.FormatMessage(code, arguments...),
+		Code:     code,
+		UnitName: pass.context.Unit.Name,
+		Position: node.Locate(),
+	})
+}
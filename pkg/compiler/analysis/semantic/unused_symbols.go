@@ -0,0 +1,262 @@
+package semantic
+
+import (
+	"strings"
+
+	"github.com/strict-lang/sdk/pkg/compiler/analysis"
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+const (
+	CodeUnusedVariable  diagnostic.Code = "S0801"
+	CodeUnusedField     diagnostic.Code = "S0802"
+	CodeUnusedParameter diagnostic.Code = "S0803"
+	CodeUnusedImport    diagnostic.Code = "S0804"
+)
+
+const UnusedSymbolPassId = "UnusedSymbolPass"
+
+func init() {
+	passes.Register(&UnusedSymbolPass{})
+}
+
+// UnusedSymbolPass warns about declarations that are never referenced. It
+// counts the identifiers, that are bound to the symbols of local variables,
+// private fields and parameters. Exported fields are not checked, since they
+// may be referenced by other units. Imports are used, if the namespace
+// symbol of their module is referenced by an identifier or a type name.
+// Imports without a namespace symbol are used, if an unresolved name refers
+// to their module. Names that are bound to other symbols, like a local that
+// is named after the module, never refer to an import.
+//
+// Unused variables, private fields and imports are reported with a fix, that
+// removes their declaration. Let bindings are not removed, since their value
+// may have side effects, and parameters are not removed, since that changes
+// the signature of the method.
+type UnusedSymbolPass struct {
+	context    *passes.Context
+	references map[scope.Symbol]int
+	usedNames  map[string]bool
+}
+
+func (pass *UnusedSymbolPass) Run(context *passes.Context) {
+	pass.context = context
+	pass.references = map[scope.Symbol]int{}
+	pass.usedNames = map[string]bool{}
+	context.Unit.AcceptRecursive(pass.createCountingVisitor())
+	context.Unit.AcceptRecursive(pass.createReportingVisitor())
+}
+
+func (pass *UnusedSymbolPass) Dependencies(isolate *isolate.Isolate) passes.Set {
+	return passes.ListInIsolate(isolate, NameResolutionPassId)
+}
+
+func (pass *UnusedSymbolPass) Id() passes.Id {
+	return UnusedSymbolPassId
+}
+
+func (pass *UnusedSymbolPass) createCountingVisitor() tree.Visitor {
+	visitor := tree.NewEmptyVisitor()
+	visitor.IdentifierVisitor = pass.countReference
+	visitor.ConcreteTypeNameVisitor = func(name *tree.ConcreteTypeName) {
+		pass.countTypeNameReference(name, name.Name)
+	}
+	visitor.GenericTypeNameVisitor = func(name *tree.GenericTypeName) {
+		pass.countTypeNameReference(name, name.Name)
+	}
+	return visitor
+}
+
+func (pass *UnusedSymbolPass) countReference(identifier *tree.Identifier) {
+	if identifier.IsPartOfDeclaration() {
+		return
+	}
+	if identifier.IsBound() {
+		pass.references[identifier.Binding()]++
+	} else {
+		pass.markNameAsUsed(identifier.Value)
+	}
+}
+
+// countTypeNameReference counts a reference to the namespaces and classes,
+// that the first part of a possibly qualified type name resolves to. This part
+// is the name of an imported module, if the name is qualified. Type names are
+// not bound to symbols and are thus resolved in their nearest scope.
+func (pass *UnusedSymbolPass) countTypeNameReference(node tree.Node, name string) {
+	qualifier := strings.SplitN(name, ".", 2)[0]
+	resolved := false
+	if nearestScope, ok := tree.ResolveNearestScope(node); ok {
+		point := scope.NewReferencePoint(qualifier)
+		for _, entry := range nearestScope.Lookup(point) {
+			if isTypeNameTarget(entry.Symbol) {
+				pass.references[entry.Symbol]++
+				resolved = true
+			}
+		}
+	}
+	if !resolved {
+		pass.markNameAsUsed(qualifier)
+	}
+}
+
+func isTypeNameTarget(symbol scope.Symbol) bool {
+	_, isNamespace := scope.AsNamespaceSymbol(symbol)
+	_, isClass := scope.AsClassSymbol(symbol)
+	return isNamespace || isClass
+}
+
+// markNameAsUsed marks the first part of an unresolved name as used. The name
+// may refer to an imported module, whose namespace symbol is not known.
+func (pass *UnusedSymbolPass) markNameAsUsed(name string) {
+	pass.usedNames[strings.SplitN(name, ".", 2)[0]] = true
+}
+
+func (pass *UnusedSymbolPass) createReportingVisitor() tree.Visitor {
+	visitor := tree.NewEmptyVisitor()
+	visitor.ImportStatementVisitor = pass.checkImport
+	visitor.FieldDeclarationVisitor = pass.checkFieldDeclaration
+	visitor.MethodDeclarationVisitor = pass.checkParameters
+	visitor.LetBindingVisitor = pass.checkLetBinding
+	visitor.MatchStatementVisitor = pass.checkMatchBindings
+	return visitor
+}
+
+// checkImport reports imports, whose module is never referenced. Imports
+// into the anonymous namespace can not be checked, since their symbols
+// are not qualified by the name of the module.
+func (pass *UnusedSymbolPass) checkImport(statement *tree.ImportStatement) {
+	name := statement.ModuleName()
+	if name == "" || pass.isImportUsed(statement) {
+		return
+	}
+	fix := pass.createRemovalFix(CodeUnusedImport, name, statement)
+	fix.MachineApplicable = true
+	pass.reportUnusedNode(statement, CodeUnusedImport, fix, name)
+}
+
+// isImportUsed returns true if the imported namespace or its top class is
+// referenced. If the import scope has no symbol for the namespace, the import
+// is used if an unresolved name refers to the module.
+func (pass *UnusedSymbolPass) isImportUsed(statement *tree.ImportStatement) bool {
+	if namespace, ok := pass.findImportedNamespace(statement); ok {
+		return pass.isNamespaceReferenced(namespace)
+	}
+	return pass.usedNames[statement.ModuleName()]
+}
+
+// findImportedNamespace looks up the symbol of the namespace, that is imported
+// by the statement. Namespaces are entered into the import scope by the last
+// part of their qualified name.
+func (pass *UnusedSymbolPass) findImportedNamespace(
+	statement *tree.ImportStatement) (*scope.Namespace, bool) {
+
+	qualifiedName := statement.ImportedNamespace()
+	name := qualifiedName[strings.LastIndex(qualifiedName, ".")+1:]
+	importScope := analysis.RequireInIsolate(pass.context.Isolate).ImportScope
+	for _, entry := range importScope.Lookup(scope.NewReferencePoint(name)) {
+		namespace, ok := scope.AsNamespaceSymbol(entry.Symbol)
+		if ok && namespace.QualifiedName == qualifiedName {
+			return namespace, true
+		}
+	}
+	return nil, false
+}
+
+func (pass *UnusedSymbolPass) isNamespaceReferenced(namespace *scope.Namespace) bool {
+	if pass.references[namespace] != 0 {
+		return true
+	}
+	point := scope.NewReferencePoint(namespace.Name())
+	topClass, ok := scope.LookupClass(namespace.Scope, point)
+	return ok && pass.references[topClass] != 0
+}
+
+// checkFieldDeclaration reports unused local variables and private fields.
+// Exported fields are skipped, since they can be referenced from other units.
+// Inferred declarations are created by the compiler and not reported.
+func (pass *UnusedSymbolPass) checkFieldDeclaration(declaration *tree.FieldDeclaration) {
+	if declaration.Inferred || declaration.IsExported() {
+		return
+	}
+	if !pass.isUnused(declaration.Name) {
+		return
+	}
+	code := CodeUnusedField
+	if tree.IsInsideOfMethod(declaration) {
+		code = CodeUnusedVariable
+	}
+	name := declaration.Name.Value
+	pass.reportUnusedNode(declaration.Name, code,
+		pass.createRemovalFix(code, name, declaration), name)
+}
+
+// checkParameters reports the unused parameters of a method. Parameters of
+// abstract methods are never used, since the methods have no body.
+func (pass *UnusedSymbolPass) checkParameters(method *tree.MethodDeclaration) {
+	if method.Abstract {
+		return
+	}
+	for _, parameter := range method.Parameters {
+		if pass.isUnused(parameter.Name) {
+			pass.reportUnusedNode(parameter.Name, CodeUnusedParameter,
+				nil, parameter.Name.Value)
+		}
+	}
+}
+
+func (pass *UnusedSymbolPass) checkLetBinding(binding *tree.LetBinding) {
+	pass.checkVariables(binding.Names)
+}
+
+func (pass *UnusedSymbolPass) checkMatchBindings(match *tree.MatchStatement) {
+	for _, arm := range match.Arms {
+		pass.checkVariables(arm.Bindings)
+	}
+}
+
+func (pass *UnusedSymbolPass) checkVariables(names []*tree.Identifier) {
+	for _, name := range names {
+		if pass.isUnused(name) {
+			pass.reportUnusedNode(name, CodeUnusedVariable, nil, name.Value)
+		}
+	}
+}
+
+// isUnused returns true if the declared name is bound to a field, that is
+// never referenced. Names that are not bound failed to be entered and are
+// already reported.
+func (pass *UnusedSymbolPass) isUnused(name *tree.Identifier) bool {
+	if !name.IsBound() {
+		return false
+	}
+	_, isField := scope.AsFieldSymbol(name.Binding())
+	return isField && pass.references[name.Binding()] == 0
+}
+
+// createRemovalFix creates a fix, that removes the unused node. Its title is
+// the fix message of the code. The fix is not machine-applicable, since the
+// removed declaration may have side effects.
+func (pass *UnusedSymbolPass) createRemovalFix(
+	code diagnostic.Code, name string, node tree.Node) *diagnostic.Fix {
+
+	title := diagnostic.FormatMessage(code.Qualify("fix"), name)
+	return diagnostic.NewRemovalFix(title, node.Locate())
+}
+
+func (pass *UnusedSymbolPass) reportUnusedNode(
+	node tree.Node, code diagnostic.Code, fix *diagnostic.Fix, arguments ...interface{}) {
+
+	pass.context.Diagnostic.Record(diagnostic.RecordedEntry{
+		Kind:     &diagnostic.Warning,
+		Stage:    &diagnostic.SemanticAnalysis,
+		Message:  diagnostic.FormatMessage(code, arguments...),
+		Code:     code,
+		UnitName: pass.context.Unit.Name,
+		Position: node.Locate(),
+		Fix:      fix,
+	})
+}
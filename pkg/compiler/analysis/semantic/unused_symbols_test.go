@@ -0,0 +1,146 @@
+package semantic
+
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+func TestUnusedSymbolPass(testing *testing.T) {
+	entries := runPass(testing, UnusedSymbolPassId, `
+import "io.h" as io
+
+has count Number
+has limit Number
+
+method increment(amount Number, unused Number) returns Number
+  has ignored Number
+  let doubled = amount * 2
+  count = count + amount
+  return count
+
+method Limit() returns Number
+  return limit
+`)
+	expectedMessages := map[string]bool{
+		"The import of io is never used":     true,
+		"The parameter unused is never used": false,
+		"The variable ignored is never used": true,
+		"The variable doubled is never used": false,
+	}
+	if len(entries) != len(expectedMessages) {
+		testing.Errorf("expected %d diagnostics but got %d",
+			len(expectedMessages), len(entries))
+	}
+	for _, entry := range entries {
+		hasFix, ok := expectedMessages[entry.Message]
+		if !ok {
+			testing.Errorf("unexpected diagnostic: %s", entry.Message)
+			continue
+		}
+		if hasFix != (len(entry.Fixes) != 0) {
+			testing.Errorf("expected diagnostic %q to have a fix: %v",
+				entry.Message, hasFix)
+		}
+	}
+}
+
+func TestUnusedSymbolPass_OnlyRemovesImportsAutomatically(testing *testing.T) {
+	entries := runPass(testing, UnusedSymbolPassId, `
+import "io.h" as io
+
+method Run()
+  has ignored Number
+`)
+	expected := map[string]bool{
+		"Remove the import of io":     true,
+		"Remove the variable ignored": false,
+	}
+	for title, machineApplicable := range expected {
+		fix, ok := findFixWithTitle(entries, title)
+		if !ok {
+			testing.Errorf("expected fix %q", title)
+			continue
+		}
+		if fix.MachineApplicable != machineApplicable {
+			testing.Errorf("expected fix %q to be machine-applicable: %v",
+				title, machineApplicable)
+		}
+	}
+}
+
+func TestUnusedSymbolPass_ReportsUnusedPrivateFields(testing *testing.T) {
+	entries := runPass(testing, UnusedSymbolPassId, `
+has ignored Number
+
+method Zero() returns Number
+  return 0
+`)
+	if len(entries) != 1 || entries[0].Code != CodeUnusedField {
+		testing.Fatalf("expected the unused field to be reported, got %+v", entries)
+	}
+	if entries[0].Message != "The field ignored is never used" {
+		testing.Errorf("unexpected diagnostic: %s", entries[0].Message)
+	}
+	fix, ok := findFixWithTitle(entries, "Remove the field ignored")
+	if !ok || fix.MachineApplicable {
+		testing.Errorf("expected a fix, that is not machine-applicable, got %+v", fix)
+	}
+}
+
+func TestUnusedSymbolPass_IgnoresUsedAndExportedFields(testing *testing.T) {
+	entries := runPass(testing, UnusedSymbolPassId, `
+has total Number
+has Limit Number
+
+method Zero() returns Number
+  return total
+`)
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
+
+// createNamespaceImportScope creates an import scope, that contains the
+// namespace stdio with its top class.
+func createNamespaceImportScope() scope.Scope {
+	namespaceScope := scope.NewOuterScope("namespace.stdio", scope.NewBuiltinScope())
+	namespaceScope.Insert(&scope.Class{
+		DeclarationName: "stdio",
+		QualifiedName:   "stdio.stdio",
+	})
+	importScope := scope.NewImportScope("Test", []scope.Symbol{
+		&scope.Namespace{
+			DeclarationName: "stdio",
+			QualifiedName:   "stdio",
+			Scope:           namespaceScope,
+		},
+	})
+	return scope.Combine(importScope.Id(), createImportScope(), importScope)
+}
+
+func TestUnusedSymbolPass_ReportsImportsHiddenByLocals(testing *testing.T) {
+	entries := runPassInScope(testing, UnusedSymbolPassId, `
+import "stdio.h" as io
+
+method Twice(value Number) returns Number
+  let io = value * 2
+  return io
+`, createNamespaceImportScope())
+	fix, ok := findFixWithTitle(entries, "Remove the import of io")
+	if !ok || !fix.MachineApplicable {
+		testing.Errorf("expected the unused import to be removed, got %+v", entries)
+	}
+}
+
+func TestUnusedSymbolPass_AcceptsImportsReferencedByTypeNames(testing *testing.T) {
+	entries := runPassInScope(testing, UnusedSymbolPassId, `
+import "stdio.h" as io
+
+method Open(file stdio) returns stdio
+  return file
+`, createNamespaceImportScope())
+	for _, entry := range entries {
+		testing.Errorf("unexpected diagnostic: %s", entry.Message)
+	}
+}
@@ -3,12 +3,15 @@ package arduino
 import (
 	backends "github.com/strict-lang/sdk/pkg/compiler/backend"
 	"github.com/strict-lang/sdk/pkg/compiler/backend/cpp"
+	"github.com/strict-lang/sdk/pkg/compiler/lowering"
 )
 
 const BackendName = "arduino"
 
 func init() {
 	backends.Register(BackendName, NewBackend)
+	// Coroutines are not supported by the toolchains of most boards.
+	lowering.RegisterYieldStrategy(BackendName, lowering.CollectYields)
 }
 
 func Generate(input backends.Input) (backends.Output, error) {
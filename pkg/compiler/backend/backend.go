@@ -38,6 +38,20 @@ type Output struct {
 type Input struct {
 	Unit        *tree.TranslationUnit
 	Diagnostics *diagnostic.Bag
+	Options     Options
+}
+
+// Options configure the code generation of a backend. They are taken from
+// the build profile that is selected when building a package.
+type Options struct {
+	// DisableContracts disables the generation of runtime checks for the
+	// preconditions and postconditions of methods.
+	DisableContracts bool
+	// LazySequences generates methods, that yield values, as lazy sequences
+	// if the backend supports them. Otherwise their yielded values are
+	// collected into a list. The C++ backend generates lazy sequences as
+	// coroutines, which require C++20.
+	LazySequences bool
 }
 
 type Backend interface {
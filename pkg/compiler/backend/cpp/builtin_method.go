@@ -0,0 +1,166 @@
+package cpp
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+// builtinMethodTemplates translates the builtin methods of lists, maps and
+// strings to C++. The templates are formatted with the receiver as the first
+// argument and the arguments of the call as the following ones.
+var builtinMethodTemplates = map[string]map[string]string{
+	scope.ListClassName: {
+		"Length":   "%[1]s.size()",
+		"IsEmpty":  "%[1]s.empty()",
+		"Contains": "(std::find(%[1]s.begin(), %[1]s.end(), %[2]s) != %[1]s.end())",
+		"IndexOf":  "(std::find(%[1]s.begin(), %[1]s.end(), %[2]s) - %[1]s.begin())",
+		"Add":      "%[1]s.push_back(%[2]s)",
+		"Remove":   "%[1]s.erase(std::remove(%[1]s.begin(), %[1]s.end(), %[2]s), %[1]s.end())",
+		"Clear":    "%[1]s.clear()",
+	},
+	scope.MapClassName: {
+		"Length":   "%[1]s.size()",
+		"IsEmpty":  "%[1]s.empty()",
+		"Contains": "(%[1]s.count(%[2]s) != 0)",
+		"Get":      "%[1]s[%[2]s]",
+		"Put":      "%[1]s[%[2]s] = %[3]s",
+		"Remove":   "%[1]s.erase(%[2]s)",
+		"Keys":     collectMapEntriesTemplate("first"),
+		"Values":   collectMapEntriesTemplate("second"),
+		"Clear":    "%[1]s.clear()",
+	},
+	"String": {
+		"Length":     "%[1]s.length()",
+		"IsEmpty":    "%[1]s.empty()",
+		"Contains":   "(%[1]s.find(%[2]s) != std::string::npos)",
+		"IndexOf":    "((int) %[1]s.find(%[2]s))",
+		"StartsWith": "(%[1]s.rfind(%[2]s, 0) == 0)",
+		"Substring":  "%[1]s.substr(%[2]s, (%[3]s) - (%[2]s))",
+	},
+}
+
+// collectMapEntriesTemplate creates a template that collects either the keys
+// or the values of a map into a vector.
+func collectMapEntriesTemplate(member string) string {
+	const typeOfMap = "std::decay<decltype(%[1]s)>::type"
+	memberType := map[string]string{"first": "key_type", "second": "mapped_type"}[member]
+	return fmt.Sprintf("[&]() { std::vector<%s::%s> entries; "+
+		"for (auto &entry : %%[1]s) { entries.push_back(entry.%s); } "+
+		"return entries; }()", typeOfMap, memberType, member)
+}
+
+// lookupBuiltinMethodTemplate returns the template of the method that is
+// called on the receiver, if the receiver is a builtin collection or string.
+func lookupBuiltinMethodTemplate(
+	receiver tree.Expression, call *tree.CallExpression) (string, bool) {
+
+	class, ok := receiver.ResolvedType()
+	name, isNamed := call.TargetName()
+	if !ok || !isNamed {
+		return "", false
+	}
+	if templates, ok := builtinMethodTemplates[builtinClassName(class)]; ok {
+		template, ok := templates[name.Value]
+		return template, ok
+	}
+	return "", false
+}
+
+func builtinClassName(class *scope.Class) string {
+	switch {
+	case scope.IsListClass(class):
+		return scope.ListClassName
+	case scope.IsMapClass(class):
+		return scope.MapClassName
+	case class == scope.Builtins.String:
+		return class.Name()
+	}
+	return ""
+}
+
+func (generation *Generation) tryToGenerateBuiltinMethodCall(
+	chain *tree.ChainExpression) bool {
+
+	call, ok := chain.LastChild().(*tree.CallExpression)
+	if !ok || len(chain.Expressions) < 2 {
+		return false
+	}
+	receiver := chain.Expressions[len(chain.Expressions)-2]
+	template, ok := lookupBuiltinMethodTemplate(receiver, call)
+	if ok && countTemplateArguments(template) == len(call.Arguments) {
+		generation.generateBuiltinMethodCall(template, chain, call)
+		return true
+	}
+	return false
+}
+
+var templateArgumentPattern = regexp.MustCompile(`%\[(\d+)]`)
+
+// countTemplateArguments returns the number of call arguments, that are used
+// by the template. The receiver is not counted. Calls with a different number
+// of arguments are reported by the semantic analysis and are not generated
+// from the template, since their arguments can not be substituted.
+func countTemplateArguments(template string) (count int) {
+	for _, match := range templateArgumentPattern.FindAllStringSubmatch(template, -1) {
+		if index, _ := strconv.Atoi(match[1]); index-1 > count {
+			count = index - 1
+		}
+	}
+	return count
+}
+
+// generateBuiltinMethodCall generates the call of a builtin method, which is
+// the last element of the chain, by formatting the methods template.
+func (generation *Generation) generateBuiltinMethodCall(
+	template string, chain *tree.ChainExpression, call *tree.CallExpression) {
+
+	arguments := []interface{}{
+		generation.generateToString(createExtensionReceiver(chain, len(chain.Expressions)-1)),
+	}
+	for _, argument := range call.Arguments {
+		arguments = append(arguments, generation.generateToString(argument.Value))
+	}
+	generation.EmitFormatted(template, arguments...)
+}
+
+// generateToString generates the code of the node into a separate buffer
+// and returns it, instead of emitting it.
+func (generation *Generation) generateToString(node tree.Node) string {
+	previous := generation.buffer
+	buffer := &strings.Builder{}
+	generation.buffer = buffer
+	generation.EmitNode(node)
+	generation.buffer = previous
+	return buffer.String()
+}
+
+func (generation *Generation) GenerateListExpression(list *tree.ListExpression) {
+	generation.Emit("{")
+	for index, element := range list.Expressions {
+		if index != 0 {
+			generation.Emit(", ")
+		}
+		generation.EmitNode(element)
+	}
+	generation.Emit("}")
+}
+
+func (generation *Generation) GenerateMapExpression(expression *tree.MapExpression) {
+	generation.Emit("{")
+	for index, entry := range expression.Entries {
+		if index != 0 {
+			generation.Emit(", ")
+		}
+		generation.Emit("{")
+		generation.EmitNode(entry.Key)
+		generation.Emit(", ")
+		generation.EmitNode(entry.Value)
+		generation.Emit("}")
+	}
+	generation.Emit("}")
+}
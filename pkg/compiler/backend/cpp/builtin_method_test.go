@@ -0,0 +1,40 @@
+package cpp
+
+import "testing"
+
+func TestGeneration_MapIsIteratedByKeys(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method countLetters(ages Map<String, Number>) returns Number
+  has count Number
+  count = 0
+  for name in ages
+    count += name.Length()
+  return count
+`)
+	expectGeneratedCode(testing, generated.source,
+		"for (std::string name : [&]() { std::vector<std::decay<decltype(ages)>::type::key_type> entries; ",
+		"entries.push_back(entry.first);")
+}
+
+func TestGeneration_BuiltinCallWithWrongArgumentCount(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method register(names List<String>, ages Map<String, Number>)
+  names.Add()
+  ages.Put("Ada")
+`)
+	expectGeneratedCode(testing, generated.source, "names.Add()", `ages.Put("Ada")`)
+}
+
+func TestCountTemplateArguments(testing *testing.T) {
+	entries := map[string]int{
+		"%[1]s.size()":           0,
+		"%[1]s.push_back(%[2]s)": 1,
+		"%[1]s[%[2]s] = %[3]s":   2,
+	}
+	for template, expected := range entries {
+		if count := countTemplateArguments(template); count != expected {
+			testing.Errorf("expected %q to use %d arguments but got %d",
+				template, expected, count)
+		}
+	}
+}
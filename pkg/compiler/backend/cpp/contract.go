@@ -0,0 +1,130 @@
+package cpp
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+// generateContractClause generates the runtime check of a precondition.
+// Postconditions are not generated at the place they are declared but
+// before every exit of the method.
+func (generation *Generation) generateContractClause(clause *tree.AssertStatement) {
+	if clause.Kind == tree.Precondition && !generation.contractsDisabled {
+		generation.generateContractCheck(clause)
+		generation.EmitEndOfLine()
+	}
+}
+
+func (generation *Generation) generateContractCheck(clause *tree.AssertStatement) {
+	generation.Emit("if (!(")
+	generation.EmitNode(clause.Expression)
+	generation.Emit(")) {\n")
+	generation.IncreaseIndent()
+	generation.EmitIndent()
+	generation.EmitFormatted("throw \"%s violated: %s\";\n",
+		clause.Kind, ComputeAssertionMessage(clause.Expression))
+	generation.DecreaseIndent()
+	generation.EmitIndent()
+	generation.Emit("}")
+}
+
+// filterGeneratedStatements removes the contract clauses from a methods body,
+// that do not generate any code at the place of their declaration.
+func (generation *Generation) filterGeneratedStatements(
+	statements []tree.Statement) (filtered []tree.Statement) {
+
+	for _, statement := range statements {
+		if !generation.isOmittedContractClause(statement) {
+			filtered = append(filtered, statement)
+		}
+	}
+	return filtered
+}
+
+func (generation *Generation) isOmittedContractClause(statement tree.Statement) bool {
+	clause, isAssert := statement.(*tree.AssertStatement)
+	if !isAssert || !clause.IsContractClause() {
+		return false
+	}
+	return generation.contractsDisabled || clause.Kind == tree.Postcondition
+}
+
+func (generation *Generation) shouldCheckPostconditions() bool {
+	return generation.method != nil &&
+		!generation.contractsDisabled &&
+		len(generation.method.declaration.Postconditions()) != 0
+}
+
+// generateReturnWithPostconditions stores the returned value in the implicit
+// result variable, checks the postconditions and then returns the result.
+func (generation *Generation) generateReturnWithPostconditions(
+	statement *tree.ReturnStatement) {
+
+	generation.Emit("{\n")
+	generation.IncreaseIndent()
+	if statement.Value != nil {
+		generation.EmitIndent()
+		generation.EmitNode(generation.method.declaration.Type)
+		generation.EmitFormatted(" %s = ", scope.PostconditionResultName)
+		generation.emitReturnedValue(statement.Value)
+		generation.Emit(";\n")
+	}
+	generation.generatePostconditionChecks()
+	generation.EmitIndent()
+	generation.emitReturnOfResult(statement)
+	generation.DecreaseIndent()
+	generation.EmitIndent()
+	generation.Emit("}")
+	generation.EmitEndOfLine()
+}
+
+// emitReturnedValue emits the value of a return statement. Values returned
+// from methods that return a Result are converted into a Result first.
+func (generation *Generation) emitReturnedValue(value tree.Expression) {
+	if generation.isGeneratingResultMethod() {
+		generation.emitResultValue(value)
+	} else {
+		generation.EmitNode(value)
+	}
+}
+
+func (generation *Generation) emitReturnOfResult(statement *tree.ReturnStatement) {
+	if statement.Value == nil {
+		generation.Emit("return;")
+	} else {
+		generation.EmitFormatted("return %s;", scope.PostconditionResultName)
+	}
+	generation.Emit("\n")
+}
+
+func (generation *Generation) generatePostconditionChecks() {
+	for _, clause := range generation.method.declaration.Postconditions() {
+		generation.EmitIndent()
+		generation.generateContractCheck(clause)
+		generation.Emit("\n")
+	}
+}
+
+// maybeCheckPostconditionsInEpilogue checks the postconditions at the end of
+// methods that do not return a value and thus may exit without a return.
+func (generation *Generation) maybeCheckPostconditionsInEpilogue(
+	definition *MethodDefinition) {
+
+	if generation.contractsDisabled || definition.returnsValue() {
+		return
+	}
+	if len(definition.declaration.Postconditions()) != 0 {
+		definition.addToEpilogue("postconditions", func() {
+			for _, clause := range definition.declaration.Postconditions() {
+				generation.EmitIndent()
+				generation.generateContractCheck(clause)
+				generation.Emit("\n")
+			}
+		})
+	}
+}
+
+func (definition *MethodDefinition) returnsValue() bool {
+	typeName := definition.declaration.Type
+	return typeName != nil && typeName.BaseName() != scope.Builtins.Void.Name()
+}
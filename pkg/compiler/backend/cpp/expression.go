@@ -2,10 +2,24 @@ package cpp
 
 import (
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
 )
 
+// GenerateIdentifier emits the name of the identifier. Identifiers that are
+// bound to overloaded methods are replaced by the mangled name of the method,
+// since C++ can not distinguish overloads by the labels of their parameters.
 func (generation *Generation) GenerateIdentifier(identifier *tree.Identifier) {
-	generation.Emit(identifier.Value)
+	generation.Emit(generateIdentifierName(identifier))
+}
+
+// generateIdentifierName returns the name of the identifier in C++. Names of
+// overloaded methods are mangled, since overloads may only differ by the
+// labels of their parameters, which C++ does not distinguish.
+func generateIdentifierName(identifier *tree.Identifier) string {
+	if method, ok := scope.AsMethodSymbol(identifier.Binding()); ok && scope.IsOverloaded(method) {
+		return scope.MangleName(method)
+	}
+	return identifier.Value
 }
 
 func (generation *Generation) GenerateStringLiteral(literal *tree.StringLiteral) {
@@ -37,15 +51,26 @@ func isPointerTarget(node tree.Node) bool {
 }
 
 func (generation *Generation) GenerateFieldSelectExpression(expression *tree.ChainExpression) {
+	index, hasExtensionCall := findLastExtensionCall(expression)
+	if hasExtensionCall && index == len(expression.Expressions)-1 {
+		generation.generateExtensionCall(expression, index)
+		return
+	}
+	if generation.tryToGenerateBuiltinMethodCall(expression) {
+		return
+	}
+	if hasExtensionCall {
+		generation.generateExtensionCall(expression, index)
+		return
+	}
 	if id, ok := expression.FirstChild().(*tree.Identifier); ok {
 		if _, moduleExists := generation.importModules[id.Value]; moduleExists {
 			generation.generateNamespaceSelector(expression)
 			return
 		}
 	}
-	remaining := expression.Expressions[1:]
-	lastIndex := len(remaining) - 1
-	for index, remaining := range remaining {
+	lastIndex := len(expression.Expressions) - 1
+	for index, remaining := range expression.Expressions {
 		generation.EmitNode(remaining)
 		if index != lastIndex {
 			if isPointerTarget(remaining) {
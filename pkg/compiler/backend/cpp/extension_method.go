@@ -0,0 +1,95 @@
+package cpp
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+// Extension methods are generated as free functions. The extended value is
+// passed as the first argument and is named like the implicit receiver.
+func createExtensionFunction(method *tree.MethodDeclaration) *tree.MethodDeclaration {
+	receiver := &tree.Parameter{
+		Type: method.Extended,
+		Name: &tree.Identifier{Value: scope.ExtensionReceiverName},
+	}
+	return &tree.MethodDeclaration{
+		Name:       method.Name,
+		Type:       method.Type,
+		Parameters: append(tree.ParameterList{receiver}, method.Parameters...),
+		Body:       method.Body,
+		Region:     method.Region,
+	}
+}
+
+func filterExtensionMethods(nodes []tree.Node) (extensions []*tree.MethodDeclaration) {
+	for _, node := range nodes {
+		if method, ok := node.(*tree.MethodDeclaration); ok && method.IsExtension() {
+			extensions = append(extensions, method)
+		}
+	}
+	return extensions
+}
+
+func isExtensionMethod(node tree.Node) bool {
+	method, ok := node.(*tree.MethodDeclaration)
+	return ok && method.IsExtension()
+}
+
+func (generation *Generation) emitExtensionFunctionDeclarations(
+	declaration *tree.ClassDeclaration) {
+
+	for _, extension := range filterExtensionMethods(declaration.Children) {
+		generation.EmitMethodDeclaration(createExtensionFunction(extension))
+		generation.Emit(";")
+		generation.EmitEndOfLine()
+	}
+}
+
+func isExtensionCall(call *tree.CallExpression) bool {
+	if name, ok := call.TargetName(); ok {
+		method, isMethod := scope.AsMethodSymbol(name.Binding())
+		return isMethod && method.IsExtension()
+	}
+	return false
+}
+
+// findLastExtensionCall returns the index of the last element in the chain,
+// that calls an extension method.
+func findLastExtensionCall(chain *tree.ChainExpression) (int, bool) {
+	for index := len(chain.Expressions) - 1; index > 0; index-- {
+		if call, ok := chain.Expressions[index].(*tree.CallExpression); ok && isExtensionCall(call) {
+			return index, true
+		}
+	}
+	return 0, false
+}
+
+// generateExtensionCall generates the call of the extension method at the
+// index of the chain. The elements preceding the call are passed as the
+// receiving argument of the free function, while the elements following it
+// are selected from the value that the function returns.
+func (generation *Generation) generateExtensionCall(chain *tree.ChainExpression, index int) {
+	call := chain.Expressions[index].(*tree.CallExpression)
+	arguments := append(
+		[]*tree.CallArgument{{Value: createExtensionReceiver(chain, index)}},
+		call.Arguments...)
+	generation.EmitNode(call.Target)
+	generation.emitArgumentList(arguments)
+	for _, remaining := range chain.Expressions[index+1:] {
+		generation.Emit(".")
+		generation.EmitNode(remaining)
+	}
+}
+
+// createExtensionReceiver creates the expression of the elements, that
+// precede the index in the chain.
+func createExtensionReceiver(chain *tree.ChainExpression, index int) tree.Expression {
+	receiver := chain.Expressions[:index]
+	if len(receiver) == 1 {
+		return receiver[0]
+	}
+	return &tree.ChainExpression{
+		Expressions: receiver,
+		Region:      chain.Region,
+	}
+}
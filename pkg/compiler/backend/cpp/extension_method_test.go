@@ -0,0 +1,25 @@
+package cpp
+
+import "testing"
+
+func TestGeneration_ExtensionCallInChain(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method Test.twin() returns Test
+  return self
+
+method String.shout() returns String
+  return self
+
+method Size() returns Number
+  return 1
+
+method Measure(other Test) returns Number
+  return other.twin().Size()
+
+method Shout(text String) returns Number
+  return text.shout().Length()
+`)
+	expectGeneratedCode(testing, generated.source,
+		"return twin(other).Size();",
+		"return shout(text).length();")
+}
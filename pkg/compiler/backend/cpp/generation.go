@@ -20,6 +20,7 @@ type Generation struct {
 	shouldInsertNamespaceSelector bool
 	shouldImportStdlibClasses     bool
 	isGeneratingApp               bool
+	contractsDisabled             bool
 	runMethod                     *tree.MethodDeclaration
 }
 
@@ -29,6 +30,7 @@ type FileNaming interface {
 
 func NewGenerationWithExtension(input backend.Input, extension Extension) *Generation {
 	generation := NewGeneration(input.Unit)
+	generation.contractsDisabled = input.Options.DisableContracts
 	extension.ModifyVisitor(generation, generation.visitor)
 	return generation
 }
@@ -2,8 +2,68 @@ package cpp
 
 import (
 	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/analysis"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis/entering"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis/semantic"
+	backends "github.com/strict-lang/sdk/pkg/compiler/backend"
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/syntax"
+	isolates "github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
 )
 
 func (generation *Generation) PrintOutput() {
 	fmt.Println(generation.output.String())
 }
+
+// generatedFiles are the header and source file, that are generated for the
+// unit of a test.
+type generatedFiles struct {
+	header string
+	source string
+}
+
+// generateForTesting parses and analyses the code as the unit Test and
+// generates its header and source file.
+func generateForTesting(testing *testing.T, code string) generatedFiles {
+	result := syntax.ParseString("Test", code)
+	if result.Error != nil {
+		testing.Fatalf("failed to parse Unit: %v", result.Error)
+	}
+	isolate := isolates.New()
+	importScope := scope.NewOuterScope("test-scope", scope.NewBuiltinScope())
+	importScope.Insert(&scope.Class{DeclarationName: "Test", QualifiedName: "Test"})
+	testAnalysis := analysis.Analysis{
+		ImportScope:    importScope,
+		NamespaceScope: importScope,
+	}
+	testAnalysis.Store(isolate)
+	context := &passes.Context{
+		Unit:       result.TranslationUnit,
+		Diagnostic: diagnostic.NewBag(),
+		Isolate:    isolate,
+	}
+	if err := entering.Run(context); err != nil {
+		testing.Fatal(err)
+	}
+	if err := semantic.Run(context); err != nil {
+		testing.Fatal(err)
+	}
+	input := backends.Input{Unit: result.TranslationUnit, Diagnostics: diagnostic.NewBag()}
+	return generatedFiles{
+		header: NewGenerationWithExtension(input, NewHeaderFileGeneration()).Generate(),
+		source: NewGenerationWithExtension(input, NewSourceFileGeneration()).Generate(),
+	}
+}
+
+func expectGeneratedCode(testing *testing.T, generated string, expected ...string) {
+	for _, code := range expected {
+		if !strings.Contains(generated, code) {
+			testing.Errorf("expected the generated code to contain %q:\n%s", code, generated)
+		}
+	}
+}
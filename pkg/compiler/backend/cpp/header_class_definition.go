@@ -41,6 +41,9 @@ func createVisitorForDefinition(definition *headerClass) tree.Visitor {
 
 func filterFieldDeclarations(nodes []tree.Node) (fields []tree.Node, others []tree.Node) {
 	for _, child := range nodes {
+		if isExtensionMethod(child) {
+			continue
+		}
 		switch child.(type) {
 		case *tree.MethodDeclaration, *tree.ConstructorDeclaration:
 			others = append(others, child)
@@ -29,6 +29,8 @@ func (generation *HeaderFileGeneration) emitPragmas() {
 func (generation *HeaderFileGeneration) generateClassDeclaration(
 	declaration *tree.ClassDeclaration) {
 
+	generation.generation.emitUnionDefinitions(declaration)
 	definition := newClassDefinition(generation.generation, declaration)
 	definition.generateCode()
+	generation.generation.emitExtensionFunctionDeclarations(declaration)
 }
@@ -0,0 +1,19 @@
+package cpp
+
+import "testing"
+
+func TestGeneration_OverloadsAreMangledInHeaderAndSource(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method Add(value Number) returns Number
+  return value
+
+method Add(fraction Float) returns Float
+  return fraction
+`)
+	expectGeneratedCode(testing, generated.header,
+		"Number Add_value_Number(Number value);",
+		"Float Add_fraction_Float(Float fraction);")
+	expectGeneratedCode(testing, generated.source,
+		"Number Test::Add_value_Number(Number value) {",
+		"Float Test::Add_fraction_Float(Float fraction) {")
+}
@@ -11,6 +11,9 @@ type MethodDefinition struct {
 	buffer      *strings.Builder
 	prologue    map[string]ProloguePart
 	epilogue    map[string]EpiloguePart
+	// propagatedResults maps the propagations of the method to the names of
+	// the temporaries, that their Results are stored in.
+	propagatedResults map[*tree.PropagationExpression]string
 }
 
 type ProloguePart func()
@@ -23,12 +26,15 @@ func (generation *Generation) NewMethodGeneration(method *tree.MethodDeclaration
 		buffer:      &strings.Builder{},
 		epilogue:    map[string]EpiloguePart{},
 		prologue:    map[string]ProloguePart{},
+
+		propagatedResults: map[*tree.PropagationExpression]string{},
 	}
 }
 
 func (generation *Generation) GenerateMethod(method *tree.MethodDeclaration) {
 	methodGenerator := generation.NewMethodGeneration(method)
 	generation.method = methodGenerator
+	generation.maybeCheckPostconditionsInEpilogue(methodGenerator)
 	methodGenerator.Emit()
 	generation.method = nil
 }
@@ -68,10 +74,11 @@ func (definition *MethodDefinition) generateBody() string {
 	// a method body. It will generate open and close brackets and this will produce
 	// faulty code, if a prologue or epilogue is generated.
 	if block, ok := definition.declaration.Body.(*tree.StatementBlock); ok {
-		for index, child := range block.Children {
+		for index, child := range definition.generation.filterGeneratedStatements(block.Children) {
 			if index != 0 {
 				definition.generation.EmitIndent()
 			}
+			definition.generation.emitPropagatedResults(child)
 			definition.generation.EmitNode(child)
 		}
 		return definition.buffer.String()
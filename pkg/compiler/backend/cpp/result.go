@@ -0,0 +1,191 @@
+package cpp
+
+import (
+	"fmt"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+// Results are not implemented using exceptions, since they are not supported
+// by every target (for example the Arduino). Instead a Result is a plain value,
+// which is checked by the caller. Failures are propagated by early returns.
+const resultDefinition = `#ifndef STRICT_RESULT_DEFINED
+#define STRICT_RESULT_DEFINED
+#include <string>
+namespace Strict {
+template <typename T>
+struct Result {
+	bool failed;
+	std::string message;
+	T value;
+
+	bool IsError() const { return failed; }
+	std::string ErrorMessage() const { return message; }
+	T Value() const { return value; }
+
+	static Result Success(T value) { return Result{false, "", value}; }
+	static Result Failure(const char *message) { return Result{true, message, T()}; }
+	static Result Failure(const std::string &message) { return Result{true, message, T()}; }
+};
+}
+#endif
+`
+
+const propagatedResultName = "$propagated"
+
+func (generation *Generation) maybeEmitResultDefinition() {
+	if usesResult(generation.Unit) {
+		generation.Emit(resultDefinition)
+	}
+}
+
+func usesResult(unit *tree.TranslationUnit) bool {
+	found := false
+	unit.AcceptRecursive(tree.VisitWith(func(node tree.Node) {
+		switch node := node.(type) {
+		case *tree.PropagationExpression:
+			found = true
+		case *tree.MethodDeclaration:
+			found = found || node.ReturnsResult()
+		case *tree.GenericTypeName:
+			found = found || node.Name == scope.ResultClassName
+		}
+	}))
+	return found
+}
+
+func (generation *Generation) isGeneratingResultMethod() bool {
+	return generation.method != nil && generation.method.declaration.ReturnsResult()
+}
+
+// generateResultReturn generates a return statement in a method that returns
+// a Result. Returned failures and Results are returned as they are, while
+// other values are wrapped into a successful Result.
+func (generation *Generation) generateResultReturn(statement *tree.ReturnStatement) {
+	generation.Emit("return ")
+	generation.emitResultValue(statement.Value)
+	generation.Emit(";")
+	generation.EmitEndOfLine()
+}
+
+func (generation *Generation) emitResultValue(value tree.Expression) {
+	switch {
+	case isErrorCreation(value):
+		generation.emitResultFailure(value.(*tree.CallExpression).Arguments[0].Value)
+	case isResult(value):
+		generation.EmitNode(value)
+	default:
+		generation.EmitNode(generation.method.declaration.Type)
+		generation.Emit("::Success(")
+		generation.EmitNode(value)
+		generation.Emit(")")
+	}
+}
+
+func (generation *Generation) emitResultFailure(message tree.Node) {
+	generation.EmitNode(generation.method.declaration.Type)
+	generation.Emit("::Failure(")
+	generation.EmitNode(message)
+	generation.Emit(")")
+}
+
+// GeneratePropagationExpression generates the value of a propagated Result.
+// Inside of methods that return a Result, the Result has already been stored
+// in a temporary by emitPropagatedResults and is only unwrapped here.
+func (generation *Generation) GeneratePropagationExpression(
+	propagation *tree.PropagationExpression) {
+
+	if generation.method != nil {
+		if name, ok := generation.method.propagatedResults[propagation]; ok {
+			generation.EmitFormatted("%s.Value()", name)
+			return
+		}
+	}
+	generation.Emit("(")
+	generation.EmitNode(propagation.Operand)
+	generation.Emit(").Value()")
+}
+
+// emitPropagatedResults is called before a statement is generated. It stores
+// the operand of every propagation in the statement in a temporary and returns
+// the failure from the enclosing method, if the operand failed. Propagations
+// in nested blocks are emitted once their own statement is generated.
+func (generation *Generation) emitPropagatedResults(statement tree.Node) {
+	if !generation.isGeneratingResultMethod() {
+		return
+	}
+	for _, propagation := range collectPropagations(statement) {
+		generation.emitPropagatedResult(propagation)
+	}
+}
+
+func (generation *Generation) emitPropagatedResult(propagation *tree.PropagationExpression) {
+	results := generation.method.propagatedResults
+	if _, ok := results[propagation]; ok {
+		return
+	}
+	for _, operand := range collectPropagations(propagation.Operand) {
+		generation.emitPropagatedResult(operand)
+	}
+	name := fmt.Sprintf("%s%d", propagatedResultName, len(results))
+	generation.EmitFormatted("auto %s = ", name)
+	generation.EmitNode(propagation.Operand)
+	generation.Emit(";\n")
+	generation.EmitIndent()
+	generation.EmitFormatted("if (%s.IsError()) return ", name)
+	generation.emitResultFailure(&tree.Identifier{Value: name + ".ErrorMessage()"})
+	generation.Emit(";\n")
+	generation.EmitIndent()
+	results[propagation] = name
+}
+
+// collectPropagations lists the propagations that are evaluated by the node,
+// excluding those in nested blocks. Propagations are listed in the order they
+// are visited in.
+func collectPropagations(node tree.Node) (propagations []*tree.PropagationExpression) {
+	nested := map[*tree.PropagationExpression]bool{}
+	node.AcceptRecursive(tree.VisitWith(func(node tree.Node) {
+		switch node := node.(type) {
+		case *tree.StatementBlock:
+			markNestedPropagations(node, nested)
+		case *tree.PropagationExpression:
+			if !nested[node] {
+				propagations = append(propagations, node)
+			}
+		}
+	}))
+	return propagations
+}
+
+func markNestedPropagations(
+	block *tree.StatementBlock, nested map[*tree.PropagationExpression]bool) {
+
+	block.AcceptRecursive(tree.VisitWith(func(node tree.Node) {
+		if propagation, ok := node.(*tree.PropagationExpression); ok {
+			nested[propagation] = true
+		}
+	}))
+}
+
+// isErrorCreation returns true if the value is a call to the builtin Error
+// method. If the call has not been resolved, its name is compared instead.
+func isErrorCreation(value tree.Expression) bool {
+	call, isCall := value.(*tree.CallExpression)
+	if !isCall || len(call.Arguments) != 1 {
+		return false
+	}
+	if name, ok := call.TargetName(); ok {
+		if name.IsBound() {
+			return name.Binding() == scope.Builtins.Error
+		}
+		return name.Value == scope.Builtins.Error.Name()
+	}
+	return false
+}
+
+func isResult(value tree.Expression) bool {
+	if class, ok := value.ResolvedType(); ok {
+		return scope.IsResultClass(class)
+	}
+	return false
+}
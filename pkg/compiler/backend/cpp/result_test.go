@@ -0,0 +1,38 @@
+package cpp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGeneration_PropagationIsStoredInTemporary(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method parse(text String) returns Result<Number>
+  if text.Length() == 0
+    return Error("text is empty")
+  return text.Length()
+
+method twice(text String) returns Result<Number>
+  return parse(text)? + parse(text)?
+`)
+	expectGeneratedCode(testing, generated.source,
+		"auto $propagated0 = parse(text);",
+		"if ($propagated0.IsError()) return Strict::Result<Number>::Failure($propagated0.ErrorMessage());",
+		"auto $propagated1 = parse(text);",
+		"return Strict::Result<Number>::Success($propagated0.Value() + $propagated1.Value());")
+	if strings.Contains(generated.source, "({") {
+		testing.Errorf("expected no statement expression in:\n%s", generated.source)
+	}
+}
+
+func TestGeneration_ResultReturnChecksPostconditions(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method parse(text String) returns Result<Number>
+  ensures !result.IsError()
+  return text.Length()
+`)
+	expectGeneratedCode(testing, generated.source,
+		"Strict::Result<Number> result = Strict::Result<Number>::Success(text.length());",
+		"postcondition violated",
+		"return result;")
+}
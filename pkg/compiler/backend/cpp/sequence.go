@@ -0,0 +1,103 @@
+package cpp
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/lowering"
+)
+
+// Methods that yield their values are lowered into coroutines, which return
+// a Sequence, if lazy sequences are enabled by the backend options. The
+// Sequence is lazy and resumes its coroutine whenever it is advanced, so
+// values are only computed once they are iterated. Coroutines require C++20,
+// compiling the generated code with an older standard fails with an error,
+// that names the required flag. By default, the yielded values are collected
+// into a vector instead.
+const sequenceDefinition = `#ifndef STRICT_SEQUENCE_DEFINED
+#define STRICT_SEQUENCE_DEFINED
+#if !defined(__cpp_impl_coroutine)
+#error "Methods that yield values are generated as coroutines, compile with -std=c++20"
+#endif
+#include <coroutine>
+#include <exception>
+#include <iterator>
+#include <memory>
+namespace Strict {
+template <typename T>
+class Sequence {
+public:
+	struct promise_type {
+		const T *current;
+
+		Sequence get_return_object() {
+			return Sequence{std::coroutine_handle<promise_type>::from_promise(*this)};
+		}
+		std::suspend_always initial_suspend() noexcept { return {}; }
+		std::suspend_always final_suspend() noexcept { return {}; }
+		std::suspend_always yield_value(const T &value) noexcept {
+			current = std::addressof(value);
+			return {};
+		}
+		void return_void() {}
+		void unhandled_exception() { std::terminate(); }
+	};
+
+	struct iterator {
+		std::coroutine_handle<promise_type> handle;
+
+		iterator &operator++() { handle.resume(); return *this; }
+		const T &operator*() const { return *handle.promise().current; }
+		bool operator!=(std::default_sentinel_t) const { return !handle.done(); }
+	};
+
+	explicit Sequence(std::coroutine_handle<promise_type> handle) : handle(handle) {}
+	Sequence(Sequence &&other) noexcept : handle(other.handle) { other.handle = nullptr; }
+	Sequence(const Sequence &) = delete;
+	~Sequence() { if (handle) handle.destroy(); }
+
+	iterator begin() { handle.resume(); return iterator{handle}; }
+	std::default_sentinel_t end() { return {}; }
+
+private:
+	std::coroutine_handle<promise_type> handle;
+};
+}
+#endif
+`
+
+func init() {
+	lowering.RegisterYieldStrategy(BackendName, lowering.GenerateYields)
+}
+
+func (generation *Generation) maybeEmitSequenceDefinition() {
+	if usesSequence(generation.Unit) {
+		generation.Emit(sequenceDefinition)
+	}
+}
+
+func usesSequence(unit *tree.TranslationUnit) bool {
+	found := false
+	unit.AcceptRecursive(tree.VisitWith(func(node tree.Node) {
+		if method, ok := node.(*tree.MethodDeclaration); ok {
+			found = found || isSequenceMethod(method)
+		}
+	}))
+	return found
+}
+
+// isSequenceMethod returns true if the method has been lowered into a
+// coroutine, which yields the values of its returned Sequence.
+func isSequenceMethod(method *tree.MethodDeclaration) bool {
+	name, ok := method.Type.(*tree.GenericTypeName)
+	return ok && name.Name == lowering.SequenceTypeName
+}
+
+func (generation *Generation) isGeneratingSequenceMethod() bool {
+	return generation.method != nil && isSequenceMethod(generation.method.declaration)
+}
+
+func (generation *Generation) generateSequenceYield(statement *tree.YieldStatement) {
+	generation.Emit("co_yield ")
+	generation.EmitNode(statement.Value)
+	generation.Emit(";")
+	generation.EmitEndOfLine()
+}
@@ -105,7 +105,12 @@ func filterDeclarations(
 func (generation *SourceFileGeneration) generateMethodDeclaration(
 	declaration *tree.MethodDeclaration) {
 
-	name := fmt.Sprintf("%s::%s", generation.className, declaration.Name.Value)
+	if declaration.IsExtension() {
+		generation.generation.GenerateMethod(createExtensionFunction(declaration))
+		return
+	}
+	name := fmt.Sprintf("%s::%s",
+		generation.className, generateIdentifierName(declaration.Name))
 	instanceMethod := &tree.MethodDeclaration{
 		Name: &tree.Identifier{
 			Value: name,
@@ -2,6 +2,8 @@ package cpp
 
 import (
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/lowering"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
 )
 
 func (generation *Generation) GenerateConditionalStatement(statement *tree.ConditionalStatement) {
@@ -15,21 +17,25 @@ func (generation *Generation) GenerateConditionalStatement(statement *tree.Condi
 	}
 }
 
-const (
-	yieldListName      = "$yield"
-	yieldGeneratorName = "yield"
-)
+const yieldGeneratorName = "yield"
 
 func (generation *Generation) GenerateBreakStatement(statement *tree.BreakStatement) {
 	generation.Emit("break;")
 	generation.EmitEndOfLine()
 }
 
+// GenerateYieldStatement generates the yield statement of a method, that has
+// been lowered into a coroutine. Yield statements of other methods append
+// their value to an implicit list, that is returned at the end of the method.
 func (generation *Generation) GenerateYieldStatement(statement *tree.YieldStatement) {
+	if generation.isGeneratingSequenceMethod() {
+		generation.generateSequenceYield(statement)
+		return
+	}
 	generation.method.addToPrologue(yieldGeneratorName, generation.declareYieldList)
 	generation.method.addToEpilogue(yieldGeneratorName, generation.returnYieldList)
 
-	generation.EmitFormatted("%s.push_back(", yieldListName)
+	generation.EmitFormatted("%s.push_back(", lowering.YieldListName)
 	generation.EmitNode(statement.Value)
 	generation.EmitFormatted(");")
 	generation.EmitEndOfLine()
@@ -40,18 +46,20 @@ func (generation *Generation) declareYieldList() {
 		panic("Yield statement outside of method")
 	}
 	generation.EmitNode(generation.method.declaration.Type)
-	generation.EmitFormatted(" %s;", yieldListName)
+	generation.EmitFormatted(" %s;", lowering.YieldListName)
 }
 
 func (generation *Generation) returnYieldList() {
 	generation.Emit("\n")
 	generation.EmitIndent()
-	generation.EmitFormatted("return %s;", yieldListName)
+	generation.EmitFormatted("return %s;", lowering.YieldListName)
 	generation.EmitEndOfLine()
 }
 
 func (generation *Generation) GenerateRangedLoopStatement(statement *tree.RangedLoopStatement) {
-	generation.EmitFormatted("for (auto %s = ", statement.Field.Value)
+	generation.Emit("for (")
+	generation.emitInferredType(statement.Field)
+	generation.EmitFormatted(" %s = ", statement.Field.Value)
 	generation.EmitNode(statement.Begin)
 	generation.EmitFormatted("; %s < ", statement.Field.Value)
 	generation.EmitNode(statement.End)
@@ -60,13 +68,118 @@ func (generation *Generation) GenerateRangedLoopStatement(statement *tree.Ranged
 }
 
 func (generation *Generation) GenerateForEachLoopStatement(statement *tree.ForEachLoopStatement) {
-	generation.EmitFormatted("for (auto %s : ", statement.Field.Value)
-	generation.EmitNode(statement.Sequence)
+	generation.Emit("for (")
+	if isListSequence(statement.Sequence) || isMapSequence(statement.Sequence) {
+		generation.emitInferredType(statement.Field)
+	} else {
+		generation.Emit("auto")
+	}
+	generation.EmitFormatted(" %s : ", statement.Field.Value)
+	generation.emitIteratedSequence(statement.Sequence)
 	generation.Emit(") ")
 	generation.EmitNode(statement.Body)
 }
 
+// emitIteratedSequence emits the sequence of a loop. Maps are iterated by
+// their keys, which is also the class that the loops variable is inferred to.
+// Since C++ iterates maps by their entries, the keys are collected first.
+func (generation *Generation) emitIteratedSequence(sequence tree.Expression) {
+	if isMapSequence(sequence) {
+		template := builtinMethodTemplates[scope.MapClassName]["Keys"]
+		generation.EmitFormatted(template, generation.generateToString(sequence))
+		return
+	}
+	generation.EmitNode(sequence)
+}
+
+// isListSequence returns true if the sequence is a list. The elements of
+// strings differ in C++, since they are iterated by their characters.
+// Their variables are declared as auto.
+func isListSequence(sequence tree.Expression) bool {
+	class, ok := sequence.ResolvedType()
+	return ok && class != nil && scope.IsListClass(class)
+}
+
+func isMapSequence(sequence tree.Expression) bool {
+	class, ok := sequence.ResolvedType()
+	return ok && class != nil && scope.IsMapClass(class)
+}
+
+// GenerateLetBinding generates a constant, that is declared with the class
+// inferred for the bound name. Bindings are generated as the expression of
+// their statement, bindings nested in other expressions are lowered before.
+func (generation *Generation) GenerateLetBinding(binding *tree.LetBinding) {
+	if len(binding.Names) > 1 {
+		generation.generateDestructuringLetBinding(binding)
+		return
+	}
+	name := binding.Names[0]
+	generation.Emit("const ")
+	generation.emitInferredType(name)
+	generation.EmitFormatted(" %s = ", name.Value)
+	generation.EmitNode(binding.Expression)
+}
+
+// generateDestructuringLetBinding binds every name to one element of the
+// value. Lists are stored in a constant, that is named after the first name,
+// and their elements are bound by index, since vectors can't be destructured
+// in C++. Other values are destructured with a structured binding. Classes
+// are not inferred for the names, they are thus declared as auto.
+func (generation *Generation) generateDestructuringLetBinding(binding *tree.LetBinding) {
+	if !isListSequence(binding.Expression) {
+		generation.Emit("const auto [")
+		generation.emitBindingNames(binding.Names)
+		generation.Emit("] = ")
+		generation.EmitNode(binding.Expression)
+		return
+	}
+	list := binding.Names[0].Value + destructuredListSuffix
+	generation.EmitFormatted("const auto %s = ", list)
+	generation.EmitNode(binding.Expression)
+	for index, name := range binding.Names {
+		generation.Emit(";\n")
+		generation.EmitIndent()
+		generation.EmitFormatted("const auto %s = %s[%d]", name.Value, list, index)
+	}
+}
+
+// destructuredListSuffix is appended to the first name of a destructuring
+// binding, to name the constant that holds the destructured list.
+const destructuredListSuffix = "_destructured"
+
+func (generation *Generation) emitBindingNames(names []*tree.Identifier) {
+	for index, name := range names {
+		if index != 0 {
+			generation.Emit(", ")
+		}
+		generation.Emit(name.Value)
+	}
+}
+
+// emitInferredType emits the class, that has been inferred for the variable.
+// Variables whose class could not be inferred are declared as auto.
+func (generation *Generation) emitInferredType(variable *tree.Identifier) {
+	class, ok := variable.ResolvedType()
+	if !ok || class == nil || class == scope.Builtins.Any || class.ActualClass == nil {
+		generation.Emit("auto")
+		return
+	}
+	generation.EmitNode(tree.ParseTypeName(variable.Region, class.ActualClass))
+}
+
 func (generation *Generation) GenerateReturnStatement(statement *tree.ReturnStatement) {
+	if generation.isGeneratingSequenceMethod() {
+		generation.Emit("co_return;")
+		return
+	}
+	if generation.shouldCheckPostconditions() {
+		generation.generateReturnWithPostconditions(statement)
+		return
+	}
+	if generation.isGeneratingResultMethod() && statement.Value != nil {
+		generation.generateResultReturn(statement)
+		return
+	}
 	if statement.Value == nil {
 		generation.Emit("return;")
 		return
@@ -101,6 +214,7 @@ func (generation *Generation) GenerateBlockStatement(block *tree.StatementBlock)
 			generation.Emit("\n")
 		}
 		generation.EmitIndent()
+		generation.emitPropagatedResults(child)
 		generation.EmitNode(child)
 	}
 	generation.appendNewLineAfterStatement = shouldAppendEndOfLineAtBegin
@@ -117,7 +231,10 @@ func (generation *Generation) GenerateExpressionStatement(statement *tree.Expres
 	generation.EmitEndOfLine()
 }
 func (generation *Generation) GenerateAssertStatement(statement *tree.AssertStatement) {
-
+	if statement.IsContractClause() {
+		generation.generateContractClause(statement)
+		return
+	}
 	generation.Emit("if (!(")
 	generation.EmitNode(statement.Expression)
 	generation.Emit(")) {")
@@ -0,0 +1,51 @@
+package cpp
+
+import "testing"
+
+func TestGeneration_LetBindingIsDeclaredWithInferredType(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method Greet(name String) returns String
+  let greeting = "Hello " + name
+  let length = greeting.Length()
+  return greeting
+`)
+	expectGeneratedCode(testing, generated.source,
+		`const std::string greeting = "Hello " + name;`,
+		"const Number length = greeting.length();")
+}
+
+func TestGeneration_DestructuringLetBindingDeclaresEveryName(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method Sum(pair Number[]) returns Number
+  let [first, second] = pair
+  return first + second
+`)
+	expectGeneratedCode(testing, generated.source,
+		"const auto first_destructured = pair;",
+		"const auto first = first_destructured[0];",
+		"const auto second = first_destructured[1];")
+}
+
+func TestGeneration_DestructuringLetBindingOfClassIsStructured(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method Split(other Test)
+  let [first, second] = other
+  log(first)
+  log(second)
+`)
+	expectGeneratedCode(testing, generated.source,
+		"const auto [first, second] = other;")
+}
+
+func TestGeneration_ListLoopVariableHasInferredType(testing *testing.T) {
+	generated := generateForTesting(testing, `
+method Print(numbers List<Number>, text String)
+  for number in numbers
+    log(number)
+  for character in text
+    log(character)
+`)
+	expectGeneratedCode(testing, generated.source,
+		"for (Number number : numbers)",
+		"for (auto character : text)")
+}
@@ -33,8 +33,11 @@ func (generation *Generation) generateMainMethodCall() {
 
 func (generation *Generation) generateImplicitImports() {
 	if generation.shouldImportStdlibClasses {
-		generation.Emit("#include <string>\n#include <vector>\n")
+		generation.Emit("#include <string>\n#include <vector>\n#include <map>\n#include <algorithm>\n")
 	}
+	generation.maybeEmitUnionIncludes()
+	generation.maybeEmitResultDefinition()
+	generation.maybeEmitSequenceDefinition()
 }
 
 func (generation *Generation) GenerateMainMethod(nodes []tree.Statement) {
@@ -5,29 +5,50 @@ import (
 )
 
 const (
-	builtinTypeInt    = "int"
-	builtinTypeFloat  = "float"
-	builtinTypeString = "std::string"
-	builtinTypeList   = "std::vector"
+	builtinTypeInt      = "int"
+	builtinTypeFloat    = "float"
+	builtinTypeString   = "std::string"
+	builtinTypeList     = "std::vector"
+	builtinTypeMap      = "std::map"
+	builtinTypeResult   = "Strict::Result"
+	builtinTypeSequence = "Strict::Sequence"
 )
 
 var builtinTypes = map[string]string{
-	"String": builtinTypeString,
-	"int":    builtinTypeInt,
-	"float":  builtinTypeFloat,
+	"String":   builtinTypeString,
+	"int":      builtinTypeInt,
+	"float":    builtinTypeFloat,
+	"Result":   builtinTypeResult,
+	"Map":      builtinTypeMap,
+	"Sequence": builtinTypeSequence,
 }
 
 func (generation *Generation) GenerateGenericTypeName(name *tree.GenericTypeName) {
-	generation.Emit(name.Name)
+	generation.Emit(lookupTypeName(name.Name))
 	generation.Emit("<")
-	generation.EmitNode(name.Arguments[0].Expression)
-	for _, remaining := range name.Arguments[1:] {
-		generation.Emit(", ")
-		generation.EmitNode(remaining.Expression)
+	for index, argument := range name.Arguments {
+		if index != 0 {
+			generation.Emit(", ")
+		}
+		generation.generateGenericArgument(argument)
 	}
 	generation.Emit(">")
 }
 
+// generateGenericArgument generates the argument of a generic type. Arguments
+// that are plain identifiers are type names and may be builtin types.
+func (generation *Generation) generateGenericArgument(argument *tree.Generic) {
+	if argument.TypeName != nil {
+		generation.EmitNode(argument.TypeName)
+		return
+	}
+	if identifier, ok := argument.Expression.(*tree.Identifier); ok {
+		generation.Emit(lookupTypeName(identifier.Value))
+		return
+	}
+	generation.EmitNode(argument.Expression)
+}
+
 func (generation *Generation) GenerateListTypeName(name *tree.ListTypeName) {
 	generation.Emit(builtinTypeList)
 	generation.Emit("<")
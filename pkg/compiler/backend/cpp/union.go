@@ -0,0 +1,214 @@
+package cpp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+// Unions are generated as std::variant of one struct per case. The structs
+// hold the payload of their case and are constructed like methods are called.
+// Payloads that are unions themselves are boxed, since unions may be recursive
+// and a struct can not contain a value of a variant that contains the struct.
+
+const matchedValueName = "$matched"
+
+func (generation *Generation) maybeEmitUnionIncludes() {
+	if usesUnions(generation.Unit) {
+		generation.Emit("#include <variant>\n#include <memory>\n")
+	}
+}
+
+func usesUnions(unit *tree.TranslationUnit) bool {
+	found := false
+	unit.AcceptRecursive(tree.VisitWith(func(node tree.Node) {
+		switch node.(type) {
+		case *tree.UnionDeclaration, *tree.MatchStatement:
+			found = true
+		}
+	}))
+	return found
+}
+
+func filterUnionDeclarations(nodes []tree.Node) (unions []*tree.UnionDeclaration) {
+	for _, node := range nodes {
+		if union, ok := node.(*tree.UnionDeclaration); ok {
+			unions = append(unions, union)
+		}
+	}
+	return unions
+}
+
+// emitUnionDefinitions emits the definitions of every union in the class.
+// The structs of the cases are declared before any variant is defined and
+// their constructors are defined after every struct, so that unions can
+// refer to each other and to themselves.
+func (generation *Generation) emitUnionDefinitions(declaration *tree.ClassDeclaration) {
+	unions := filterUnionDeclarations(declaration.Children)
+	if len(unions) == 0 {
+		return
+	}
+	for _, union := range unions {
+		for _, unionCase := range union.Cases {
+			generation.EmitFormatted("struct %s;\n", unionCase.Name.Value)
+		}
+	}
+	for _, union := range unions {
+		generation.emitVariantDefinition(union)
+	}
+	for _, union := range unions {
+		for _, unionCase := range union.Cases {
+			generation.emitCaseStruct(unionCase)
+		}
+	}
+	for _, union := range unions {
+		for _, unionCase := range union.Cases {
+			generation.emitCaseConstructor(unionCase)
+		}
+	}
+	generation.Emit("\n")
+}
+
+func (generation *Generation) emitVariantDefinition(union *tree.UnionDeclaration) {
+	caseNames := make([]string, len(union.Cases))
+	for index, unionCase := range union.Cases {
+		caseNames[index] = unionCase.Name.Value
+	}
+	generation.EmitFormatted("using %s = std::variant<%s>;\n",
+		union.Name.Value, strings.Join(caseNames, ", "))
+}
+
+func (generation *Generation) emitCaseStruct(unionCase *tree.UnionCase) {
+	generation.EmitFormatted("struct %s {\n", unionCase.Name.Value)
+	for index, parameter := range unionCase.Payload {
+		generation.EmitFormatted("\t%s %s;\n",
+			generation.generatePayloadType(unionCase, index), parameter.Name.Value)
+	}
+	if len(unionCase.Payload) != 0 {
+		generation.EmitFormatted("\t%s(%s);\n",
+			unionCase.Name.Value, generation.generatePayloadParameters(unionCase))
+	}
+	generation.Emit("};\n")
+}
+
+func (generation *Generation) emitCaseConstructor(unionCase *tree.UnionCase) {
+	if len(unionCase.Payload) == 0 {
+		return
+	}
+	initializers := make([]string, len(unionCase.Payload))
+	for index, parameter := range unionCase.Payload {
+		name := parameter.Name.Value
+		if isBoxedPayload(unionCase, index) {
+			boxedType := generation.generateToString(parameter.Type)
+			initializers[index] = fmt.Sprintf("%s(std::make_shared<%s>(%s))", name, boxedType, name)
+		} else {
+			initializers[index] = fmt.Sprintf("%s(%s)", name, name)
+		}
+	}
+	generation.EmitFormatted("inline %s::%s(%s) : %s {}\n",
+		unionCase.Name.Value,
+		unionCase.Name.Value,
+		generation.generatePayloadParameters(unionCase),
+		strings.Join(initializers, ", "))
+}
+
+func (generation *Generation) generatePayloadParameters(unionCase *tree.UnionCase) string {
+	parameters := make([]string, len(unionCase.Payload))
+	for index, parameter := range unionCase.Payload {
+		parameters[index] = fmt.Sprintf("%s %s",
+			generation.generateToString(parameter.Type), parameter.Name.Value)
+	}
+	return strings.Join(parameters, ", ")
+}
+
+func (generation *Generation) generatePayloadType(
+	unionCase *tree.UnionCase, index int) string {
+
+	typeName := generation.generateToString(unionCase.Payload[index].Type)
+	if isBoxedPayload(unionCase, index) {
+		return fmt.Sprintf("std::shared_ptr<%s>", typeName)
+	}
+	return typeName
+}
+
+func isBoxedPayload(unionCase *tree.UnionCase, index int) bool {
+	if constructor, ok := scope.AsMethodSymbol(unionCase.Name.Binding()); ok {
+		return isBoxedField(constructor.Parameters[index])
+	}
+	return false
+}
+
+func isBoxedField(field *scope.Field) bool {
+	return field.Class != nil && scope.IsUnionClass(field.Class)
+}
+
+// GenerateMatchStatement generates a chain of conditionals, that check which
+// alternative the matched variant holds. The payload of the alternative is
+// bound to references at the beginning of the arms body.
+func (generation *Generation) GenerateMatchStatement(match *tree.MatchStatement) {
+	generation.Emit("{\n")
+	generation.IncreaseIndent()
+	generation.EmitIndent()
+	generation.EmitFormatted("auto &&%s = ", matchedValueName)
+	generation.EmitNode(match.Subject)
+	generation.Emit(";\n")
+	generation.EmitIndent()
+	union, _ := match.Subject.ResolvedType()
+	for index, arm := range match.Arms {
+		if index != 0 {
+			generation.Emit(" else ")
+		}
+		if !arm.IsDefault() {
+			generation.EmitFormatted("if (std::holds_alternative<%s>(%s)) ",
+				arm.Case.Value, matchedValueName)
+		}
+		generation.EmitNode(createMatchArmBlock(arm, union))
+	}
+	generation.DecreaseIndent()
+	generation.Emit("\n")
+	generation.EmitIndent()
+	generation.Emit("}")
+	generation.EmitEndOfLine()
+}
+
+func createMatchArmBlock(arm *tree.MatchArm, union *scope.Class) *tree.StatementBlock {
+	var children []tree.Statement
+	for index, binding := range arm.Bindings {
+		if declaration, ok := createMatchBinding(arm, union, index); ok {
+			children = append(children, &tree.ExpressionStatement{
+				Expression: &tree.Identifier{Value: declaration, Region: binding.Region},
+			})
+		}
+	}
+	return &tree.StatementBlock{
+		Children: append(children, arm.Body.Children...),
+		Region:   arm.Body.Region,
+	}
+}
+
+// createMatchBinding creates the declaration of a reference to the payload
+// of the matched case, which is bound to the name at the index.
+func createMatchBinding(
+	arm *tree.MatchArm, union *scope.Class, index int) (string, bool) {
+
+	if union == nil {
+		return "", false
+	}
+	constructor, ok := scope.LookupUnionCase(union, arm.Case.Value)
+	if !ok || index >= len(constructor.Parameters) {
+		return "", false
+	}
+	field := constructor.Parameters[index]
+	dereference := ""
+	if isBoxedField(field) {
+		dereference = "*"
+	}
+	return fmt.Sprintf("auto &%s = %sstd::get<%s>(%s).%s",
+		arm.Bindings[index].Value,
+		dereference,
+		arm.Case.Value,
+		matchedValueName,
+		field.DeclarationName), true
+}
@@ -11,6 +11,7 @@ func CreateGenericCppVisitor(generation *Generation) *tree.DelegatingVisitor {
 	visitor.ConcreteTypeNameVisitor = generation.GenerateConcreteTypeName
 	visitor.CreateExpressionVisitor = generation.GenerateCreateExpression
 	visitor.PostfixExpressionVisitor = generation.GeneratePostfixExpression
+	visitor.PropagationExpressionVisitor = generation.GeneratePropagationExpression
 	visitor.EmptyStatementVisitor = generation.GenerateEmptyStatement
 	visitor.GenericTypeNameVisitor = generation.GenerateGenericTypeName
 	visitor.InvalidStatementVisitor = generation.GenerateInvalidStatement
@@ -39,5 +40,9 @@ func CreateGenericCppVisitor(generation *Generation) *tree.DelegatingVisitor {
 	visitor.ConditionalStatementVisitor = generation.GenerateConditionalStatement
 	visitor.ForEachLoopStatementVisitor = generation.GenerateForEachLoopStatement
 	visitor.ListSelectExpressionVisitor = generation.GenerateListSelectExpression
+	visitor.ListExpressionVisitor = generation.GenerateListExpression
+	visitor.MapExpressionVisitor = generation.GenerateMapExpression
+	visitor.MatchStatementVisitor = generation.GenerateMatchStatement
+	visitor.LetBindingVisitor = generation.GenerateLetBinding
 	return visitor
 }
@@ -3,6 +3,9 @@ package compiler
 import (
 	"fmt"
 	"github.com/strict-lang/sdk/pkg/buildtool"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis/entering"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis/semantic"
 	"github.com/strict-lang/sdk/pkg/compiler/backend"
 	_ "github.com/strict-lang/sdk/pkg/compiler/backend/arduino"
 	_ "github.com/strict-lang/sdk/pkg/compiler/backend/cpp"
@@ -24,6 +27,8 @@ type Compilation struct {
 	Source  Source
 	Name    string
 	Backend string
+	// Options configure the lowering and the code generation of the backend.
+	Options backend.Options
 
 	beginTime   time.Time
 	diagnostics *diagnostic.Diagnostics
@@ -79,7 +84,9 @@ func (compilation *Compilation) Compile() Result {
 			LineMap:        parseResult.LineMap,
 		}
 	}
-	compilation.Lower(parseResult.TranslationUnit)
+	analysisDiagnostics := AnalyseAndLower(
+		parseResult.TranslationUnit, compilation.Backend, compilation.Options)
+	compilation.diagnostics = compilation.diagnostics.Merge(analysisDiagnostics)
 	generatedFiles, err := compilation.generateOutput(parseResult.TranslationUnit)
 
 	return Result{
@@ -91,13 +98,50 @@ func (compilation *Compilation) Compile() Result {
 	}
 }
 
-func (compilation *Compilation) Lower(unit *tree.TranslationUnit) {
-	execution, _ := pass.NewExecution(lowering.LetBindingLoweringPassId, &pass.Context{
+// AnalyseAndLower analyses the unit on its own and lowers it for the backend.
+// Lowering relies on the symbols that are bound by the analysis, both thus
+// run in the same isolate. The diagnostics of the analysis are returned.
+func AnalyseAndLower(
+	unit *tree.TranslationUnit,
+	backendName string,
+	options backend.Options) *diagnostic.Diagnostics {
+
+	isolate := isolates.New()
+	analysis.CreateStandalone(unit).Store(isolate)
+	recorder := diagnostic.NewBag()
+	context := &pass.Context{
+		Unit:       unit,
+		Diagnostic: recorder,
+		Isolate:    isolate,
+	}
+	if err := entering.Run(context); err != nil {
+		log.Printf("could not run entering: %v", err)
+	}
+	if err := semantic.Run(context); err != nil {
+		log.Printf("could not run analysis: %v", err)
+	}
+	lowerInAnalysedIsolate(unit, backendName, options, isolate)
+	return recorder.CreateDiagnostics(diagnostic.ConvertWithLineMap(unit.LineMap))
+}
+
+// lowerInAnalysedIsolate lowers the unit, which has already been analysed in
+// the isolate. The lowering reruns the name resolution, its diagnostics have
+// thus already been recorded by the analysis and are dropped.
+func lowerInAnalysedIsolate(
+	unit *tree.TranslationUnit,
+	backendName string,
+	options backend.Options,
+	isolate *isolates.Isolate) {
+
+	lowering.SelectBackend(isolate, backendName, options)
+	err := lowering.Run(&pass.Context{
 		Unit:       unit,
 		Diagnostic: diagnostic.NewBag(),
-		Isolate:    isolates.SingleThreaded(),
+		Isolate:    isolate,
 	})
-	_ = execution.Run()
+	if err != nil {
+		log.Printf("could not run lowering: %v", err)
+	}
 }
 
 func (compilation *Compilation) parse() syntax.Result {
@@ -110,6 +154,7 @@ func (compilation *Compilation) generateOutput(
 	output, err := compilation.invokeBackend(backend.Input{
 		Unit:        unit,
 		Diagnostics: diagnostic.NewBag(),
+		Options:     compilation.Options,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,89 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/backend"
+	"github.com/strict-lang/sdk/pkg/compiler/backend/arduino"
+	"github.com/strict-lang/sdk/pkg/compiler/backend/cpp"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/syntax"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/lowering"
+)
+
+const yieldingMethod = `
+method doubled(numbers Number[]) returns Number[]
+  for number in numbers
+    yield number * 2
+
+method pairs(numbers Number[]) returns Number[][]
+  for number in numbers
+    yield [number, number]
+`
+
+func countYieldStatements(unit *tree.TranslationUnit) (count int) {
+	unit.AcceptRecursive(tree.VisitWith(func(node tree.Node) {
+		if _, ok := node.(*tree.YieldStatement); ok {
+			count++
+		}
+	}))
+	return count
+}
+
+func TestAnalyseAndLower_LowersYieldStatements(testing *testing.T) {
+	result := syntax.ParseString("generator", yieldingMethod)
+	if result.Error != nil {
+		testing.Fatalf("failed to parse Unit: %v", result.Error)
+	}
+	diagnostics := AnalyseAndLower(
+		result.TranslationUnit, arduino.BackendName, backend.Options{})
+	if entries := diagnostics.ListEntries(); len(entries) != 0 {
+		testing.Errorf("expected no diagnostics, got %+v", entries)
+	}
+	if count := countYieldStatements(result.TranslationUnit); count != 0 {
+		testing.Errorf("expected every yield statement to be lowered, %d remain", count)
+	}
+}
+
+func compileYieldingMethod(testing *testing.T, options backend.Options) string {
+	compilation := &Compilation{
+		Source:  &InMemorySource{Source: yieldingMethod},
+		Name:    "generator",
+		Backend: cpp.BackendName,
+		Options: options,
+	}
+	result := compilation.Compile()
+	if result.Error != nil {
+		testing.Fatal(result.Error)
+	}
+	var generated strings.Builder
+	for _, file := range result.GeneratedFiles {
+		generated.Write(file.Content)
+	}
+	return generated.String()
+}
+
+func TestCompile_GeneratesSequencesOfYieldedValues(testing *testing.T) {
+	generated := compileYieldingMethod(testing, backend.Options{LazySequences: true})
+	expected := []string{
+		"Strict::Sequence<Number>",
+		"Strict::Sequence<std::vector<Number>>",
+		"co_yield",
+	}
+	for _, code := range expected {
+		if !strings.Contains(generated, code) {
+			testing.Errorf("expected the generated code to contain %q:\n%s", code, generated)
+		}
+	}
+}
+
+func TestCompile_CollectsYieldedValuesByDefault(testing *testing.T) {
+	generated := compileYieldingMethod(testing, backend.Options{})
+	if strings.Contains(generated, "co_yield") || strings.Contains(generated, "Sequence") {
+		testing.Errorf("expected no coroutines to be generated:\n%s", generated)
+	}
+	if !strings.Contains(generated, lowering.YieldListName) {
+		testing.Errorf("expected the yielded values to be collected:\n%s", generated)
+	}
+}
@@ -0,0 +1,189 @@
+package diagnostic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultLocale is the locale of the messages, that are reported if no other
+// locale is selected. Every message has a template in the default locale,
+// other locales may only translate some of them.
+const DefaultLocale = "en"
+
+// Messages are keyed by the code of their diagnostic. Messages that belong to
+// a diagnostic but are not reported as its message, like the titles of its
+// fixes, are keyed by the code and a qualifier.
+func (code Code) Qualify(qualifier string) Code {
+	return code + "." + Code(qualifier)
+}
+
+// Catalog is a translation file, that contains the message templates of a
+// single locale. Templates are formatted with the arguments of the message,
+// their verbs can be indexed to reorder the arguments.
+type Catalog struct {
+	Locale   string          `yaml:"locale"`
+	Messages map[Code]string `yaml:"messages"`
+}
+
+var catalogs = map[string]map[Code]string{}
+
+var selectedLocale = DefaultLocale
+
+// RegisterMessages registers the message templates of the locale. It panics
+// if a message of the locale is registered twice.
+func RegisterMessages(locale string, messages map[Code]string) {
+	locale = normalizeLocale(locale)
+	catalog, ok := catalogs[locale]
+	if !ok {
+		catalog = map[Code]string{}
+		catalogs[locale] = catalog
+	}
+	for code, template := range messages {
+		if _, ok := catalog[code]; ok {
+			panic(fmt.Sprintf("message %s is registered twice in locale %s", code, locale))
+		}
+		catalog[code] = template
+	}
+}
+
+// LoadCatalog loads the translation file at the path and registers its
+// messages. Messages that are already registered are overridden by the file.
+// It returns the locale of the file.
+func LoadCatalog(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read translation file: %v", err)
+	}
+	var catalog Catalog
+	if err := yaml.Unmarshal(content, &catalog); err != nil {
+		return "", fmt.Errorf("could not parse translation file: %v", err)
+	}
+	if catalog.Locale == "" {
+		return "", fmt.Errorf("translation file %s does not declare its locale", path)
+	}
+	for code, translation := range catalog.Messages {
+		template, ok := catalogs[DefaultLocale][code]
+		if !ok {
+			return "", fmt.Errorf("translation file %s contains unknown message %s", path, code)
+		}
+		if err := CheckTranslation(template, translation); err != nil {
+			return "", fmt.Errorf("translation of %s in %s is invalid: %v", code, path, err)
+		}
+	}
+	locale := normalizeLocale(catalog.Locale)
+	if _, ok := catalogs[locale]; !ok {
+		catalogs[locale] = map[Code]string{}
+	}
+	for code, template := range catalog.Messages {
+		catalogs[locale][code] = template
+	}
+	return locale, nil
+}
+
+// CheckTranslation checks that the translation formats the same arguments as
+// the template and that every argument is formatted with the same verb. The
+// arguments may be formatted in a different order, if their verbs are indexed.
+func CheckTranslation(template string, translation string) error {
+	expected, actual := listArgumentVerbs(template), listArgumentVerbs(translation)
+	if len(expected) != len(actual) {
+		return fmt.Errorf("it has %d arguments instead of %d", len(actual), len(expected))
+	}
+	for index, verb := range expected {
+		if actual[index] != verb {
+			return fmt.Errorf("argument %d is formatted with %%%c instead of %%%c",
+				index+1, actual[index], verb)
+		}
+	}
+	return nil
+}
+
+// listArgumentVerbs lists the verbs of the template in the order of the
+// arguments, that they format. Escaped percent signs are no verbs. Verbs
+// without an explicit index format the argument after the previous one.
+func listArgumentVerbs(template string) []rune {
+	var verbs []rune
+	runes := []rune(template)
+	argument := 0
+	for index := 0; index < len(runes); index++ {
+		if runes[index] != '%' {
+			continue
+		}
+		index++
+		for index < len(runes) && strings.ContainsRune("+-# 0123456789.", runes[index]) {
+			index++
+		}
+		if index < len(runes) && runes[index] == '[' {
+			end := index + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if explicit, err := strconv.Atoi(string(runes[index+1 : end])); err == nil {
+				argument = explicit - 1
+			}
+			index = end + 1
+		}
+		if index >= len(runes) || runes[index] == '%' {
+			continue
+		}
+		for len(verbs) <= argument {
+			verbs = append(verbs, 0)
+		}
+		verbs[argument] = runes[index]
+		argument++
+	}
+	return verbs
+}
+
+// SelectLocale selects the locale, in which messages are formatted. Locales
+// are matched by their language, a locale of `de_DE.UTF-8` selects the `de`
+// messages. It fails if there are no messages in the locale.
+func SelectLocale(locale string) error {
+	normalized := normalizeLocale(locale)
+	if _, ok := catalogs[normalized]; !ok {
+		return fmt.Errorf("there are no messages in the locale %s", locale)
+	}
+	selectedLocale = normalized
+	return nil
+}
+
+// SelectedLocale returns the locale, in which messages are formatted.
+func SelectedLocale() string {
+	return selectedLocale
+}
+
+// ListMessages returns a copy of the message templates of the locale. It is
+// used to compare the messages of a translation with the default locale.
+func ListMessages(locale string) map[Code]string {
+	messages := map[Code]string{}
+	for code, template := range catalogs[normalizeLocale(locale)] {
+		messages[code] = template
+	}
+	return messages
+}
+
+// FormatMessage formats the template of the message with the code in the
+// selected locale. Messages that are not translated are formatted in the
+// default locale.
+func FormatMessage(code Code, arguments ...interface{}) string {
+	if template, ok := catalogs[selectedLocale][code]; ok {
+		return fmt.Sprintf(template, arguments...)
+	}
+	if template, ok := catalogs[DefaultLocale][code]; ok {
+		return fmt.Sprintf(template, arguments...)
+	}
+	return string(code)
+}
+
+// normalizeLocale strips the territory, encoding and modifier off the locale
+// and only keeps its language.
+func normalizeLocale(locale string) string {
+	language := strings.ToLower(locale)
+	if index := strings.IndexAny(language, "_-.@"); index >= 0 {
+		language = language[:index]
+	}
+	return language
+}
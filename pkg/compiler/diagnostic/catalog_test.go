@@ -0,0 +1,123 @@
+package diagnostic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFormatMessage_FallsBackToDefaultLocale(testing *testing.T) {
+	defer SelectLocale(DefaultLocale)
+	RegisterMessages(DefaultLocale, map[Code]string{"T0101": "only %s"})
+	if err := SelectLocale("de_DE.UTF-8"); err != nil {
+		testing.Fatal(err)
+	}
+	if locale := SelectedLocale(); locale != "de" {
+		testing.Errorf("expected locale de to be selected but got %s", locale)
+	}
+	if message := FormatMessage("T0101", "english"); message != "only english" {
+		testing.Errorf("expected untranslated message to be english but got %q", message)
+	}
+	if message := FormatMessage("T0102"); message != "T0102" {
+		testing.Errorf("expected unknown message to be its code but got %q", message)
+	}
+}
+
+func TestSelectLocale_RejectsUnknownLocale(testing *testing.T) {
+	if err := SelectLocale("xx_XX"); err == nil {
+		testing.Error("expected selecting an unknown locale to fail")
+	}
+	if locale := SelectedLocale(); locale != DefaultLocale {
+		testing.Errorf("expected locale to stay %s but got %s", DefaultLocale, locale)
+	}
+}
+
+func TestLoadCatalog(testing *testing.T) {
+	defer SelectLocale(DefaultLocale)
+	RegisterMessages(DefaultLocale, map[Code]string{"T0201": "collision of %s"})
+	directory := createTemporaryDirectory(testing)
+	defer os.RemoveAll(directory)
+	path := writeCatalog(testing, directory, `
+locale: nl_NL
+messages:
+  T0201: "botsing van %s"
+`)
+	locale, err := LoadCatalog(path)
+	if err != nil {
+		testing.Fatal(err)
+	}
+	if err := SelectLocale(locale); err != nil {
+		testing.Fatal(err)
+	}
+	if message := FormatMessage("T0201", "x"); message != "botsing van x" {
+		testing.Errorf("expected translated message but got %q", message)
+	}
+}
+
+func TestLoadCatalog_RejectsUnknownMessages(testing *testing.T) {
+	directory := createTemporaryDirectory(testing)
+	defer os.RemoveAll(directory)
+	path := writeCatalog(testing, directory, `
+locale: nl
+messages:
+  T0299: "onbekend"
+`)
+	if _, err := LoadCatalog(path); err == nil {
+		testing.Error("expected loading an unknown message to fail")
+	}
+}
+
+func TestLoadCatalog_RejectsMismatchedArguments(testing *testing.T) {
+	RegisterMessages(DefaultLocale, map[Code]string{"T0301": "%s of %d%%"})
+	directory := createTemporaryDirectory(testing)
+	defer os.RemoveAll(directory)
+	path := writeCatalog(testing, directory, `
+locale: nl
+messages:
+  T0301: "%s van 100%%"
+`)
+	if _, err := LoadCatalog(path); err == nil {
+		testing.Error("expected loading a translation with missing arguments to fail")
+	}
+}
+
+func TestLoadCatalog_RejectsMismatchedVerbs(testing *testing.T) {
+	RegisterMessages(DefaultLocale, map[Code]string{"T0401": "%s expects %d arguments"})
+	directory := createTemporaryDirectory(testing)
+	defer os.RemoveAll(directory)
+	path := writeCatalog(testing, directory, `
+locale: nl
+messages:
+  T0401: "%d verwacht %s argumenten"
+`)
+	if _, err := LoadCatalog(path); err == nil {
+		testing.Error("expected loading a translation with swapped verbs to fail")
+	}
+}
+
+func TestCheckTranslation_AcceptsIndexedVerbs(testing *testing.T) {
+	template := "%s expects %d arguments"
+	if err := CheckTranslation(template, "%[2]d Argumente erwartet %[1]s"); err != nil {
+		testing.Errorf("expected reordered arguments to be accepted: %v", err)
+	}
+	if err := CheckTranslation(template, "%[2]s Argumente erwartet %[1]d"); err == nil {
+		testing.Error("expected reordered arguments with swapped verbs to be rejected")
+	}
+}
+
+func createTemporaryDirectory(testing *testing.T) string {
+	directory, err := ioutil.TempDir("", "catalog")
+	if err != nil {
+		testing.Fatal(err)
+	}
+	return directory
+}
+
+func writeCatalog(testing *testing.T, directory string, content string) string {
+	path := filepath.Join(directory, "messages.yml")
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		testing.Fatal(err)
+	}
+	return path
+}
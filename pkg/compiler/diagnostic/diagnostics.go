@@ -8,6 +8,11 @@ func Empty() *Diagnostics {
 	return &Diagnostics{}
 }
 
+// FromEntries creates diagnostics, that consist of the entries.
+func FromEntries(entries []Entry) *Diagnostics {
+	return &Diagnostics{entries: entries}
+}
+
 func (diagnostics *Diagnostics) Merge(target *Diagnostics) *Diagnostics {
 	mergedEntries := append(diagnostics.entries, target.entries...)
 	return &Diagnostics{entries: mergedEntries}
@@ -9,9 +9,14 @@ type Entry struct {
 	Stage    *Stage
 	Source   string
 	Message  string
+	Code     Code
 	UnitName string
 	Position Position
 	Error    *RichError
+	// Fixes are the fixes of the entry. The first fix is the preferred one.
+	Fixes []PositionedFix
+	// Labels are secondary regions, that are related to the cause of the entry.
+	Labels []PositionedLabel
 }
 
 type Position struct {
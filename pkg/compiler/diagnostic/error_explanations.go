@@ -0,0 +1,76 @@
+package diagnostic
+
+func init() {
+	RegisterExplanations(
+		&Explanation{
+			Code:  CodeUnexpectedToken,
+			Title: "Unexpected token",
+			Description: `
+The parser expected a specific token, like a closing parenthesis or a name,
+but found a different one. The code before the reported position is often
+incomplete, for example because a parenthesis or an operand is missing.`,
+			Examples: []Example{
+				{
+					Erroneous: `
+method add(left Number, right Number returns Number
+  return left + right`,
+					Corrected: `
+method add(left Number, right Number) returns Number
+  return left + right`,
+				},
+			},
+		},
+		&Explanation{
+			Code:  CodeInvalidStatement,
+			Title: "Invalid statement",
+			Description: `
+The parser could not complete the statement, that it is parsing. This error
+is usually reported together with a more specific error at the same position.
+Fixing the other error resolves this one as well.`,
+		},
+		&Explanation{
+			Code:  CodeInvalidIndentation,
+			Title: "Invalid indentation",
+			Description: `
+Blocks are formed by indentation. The statements of a block have to be
+indented by exactly one level more than the statement, that opens the block,
+and every statement in the block has to have the same indentation. Mixing
+tabs and spaces commonly causes this error.`,
+			Examples: []Example{
+				{
+					Erroneous: `
+method sign(value Number) returns Number
+  if value < 0
+      return 0 - 1
+  return 1`,
+					Corrected: `
+method sign(value Number) returns Number
+  if value < 0
+    return 0 - 1
+  return 1`,
+				},
+			},
+		},
+		&Explanation{
+			Code:  CodeNameCollision,
+			Title: "Name collision",
+			Description: `
+Two declarations in the same scope have the same name. Every field, variable
+and parameter has to be named uniquely within its scope, otherwise references
+to the name would be ambiguous.`,
+			Examples: []Example{
+				{
+					Erroneous: `
+method total(numbers Number[]) returns Number
+  let total = 0
+  let total = numbers.Length()
+  return total`,
+					Corrected: `
+method total(numbers Number[]) returns Number
+  let count = numbers.Length()
+  return count`,
+				},
+			},
+		},
+	)
+}
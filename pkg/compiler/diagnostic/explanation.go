@@ -0,0 +1,92 @@
+package diagnostic
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Code is the stable identifier of a diagnostic. Unlike the message, it is
+// never changed once it is assigned and can thus be referred to from outside
+// of the compiler. Codes consist of a letter, that names the stage which
+// reports the diagnostic, followed by four digits.
+type Code string
+
+// Explanation is the long-form description of the diagnostics with a code.
+type Explanation struct {
+	Code        Code
+	Title       string
+	Description string
+	Examples    []Example
+}
+
+// Example is a snippet of code, that causes the explained diagnostic, and
+// the corrected snippet, that does not cause it.
+type Example struct {
+	Erroneous string
+	Corrected string
+}
+
+var explanations = map[Code]*Explanation{}
+
+// RegisterExplanations registers the explanations, so that they can be looked
+// up by their code. It panics if a code is already explained, since every
+// code may only be assigned once.
+func RegisterExplanations(registered ...*Explanation) {
+	for _, explanation := range registered {
+		if _, ok := explanations[explanation.Code]; ok {
+			panic(fmt.Sprintf("code %s is explained twice", explanation.Code))
+		}
+		explanations[explanation.Code] = explanation
+	}
+}
+
+// LookupExplanation looks up the explanation of the code. Codes are looked up
+// regardless of their case.
+func LookupExplanation(code Code) (*Explanation, bool) {
+	explanation, ok := explanations[Code(strings.ToUpper(string(code)))]
+	return explanation, ok
+}
+
+// ListExplanations lists all registered explanations, ordered by their code.
+func ListExplanations() []*Explanation {
+	listed := make([]*Explanation, 0, len(explanations))
+	for _, explanation := range explanations {
+		listed = append(listed, explanation)
+	}
+	sort.Slice(listed, func(left, right int) bool {
+		return listed[left].Code < listed[right].Code
+	})
+	return listed
+}
+
+const exampleIndent = "    "
+
+// Write writes the explanation with its examples to the writer.
+func (explanation *Explanation) Write(writer io.Writer) error {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("%s: %s\n\n", explanation.Code, explanation.Title))
+	output.WriteString(strings.TrimSpace(explanation.Description))
+	output.WriteString("\n")
+	for _, example := range explanation.Examples {
+		output.WriteString("\nErroneous code example:\n\n")
+		writeIndentedSnippet(&output, example.Erroneous)
+		if example.Corrected != "" {
+			output.WriteString("\nCorrected code example:\n\n")
+			writeIndentedSnippet(&output, example.Corrected)
+		}
+	}
+	_, err := io.WriteString(writer, output.String())
+	return err
+}
+
+func writeIndentedSnippet(output *strings.Builder, snippet string) {
+	for _, line := range strings.Split(strings.Trim(snippet, "\n"), "\n") {
+		if line != "" {
+			output.WriteString(exampleIndent)
+			output.WriteString(line)
+		}
+		output.WriteString("\n")
+	}
+}
@@ -0,0 +1,64 @@
+package diagnostic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+)
+
+func TestLookupExplanation_IgnoresCase(testing *testing.T) {
+	explanation, ok := LookupExplanation("p0003")
+	if !ok || explanation.Code != CodeInvalidIndentation {
+		testing.Errorf("expected the explanation of %s to be found", CodeInvalidIndentation)
+	}
+	if _, ok := LookupExplanation("X0000"); ok {
+		testing.Error("expected unknown codes to not be explained")
+	}
+}
+
+func TestRegisterExplanations_RejectsDuplicateCodes(testing *testing.T) {
+	defer func() {
+		if recover() == nil {
+			testing.Error("expected registering a duplicate code to panic")
+		}
+	}()
+	RegisterExplanations(&Explanation{Code: CodeNameCollision})
+}
+
+func TestExplanation_Write(testing *testing.T) {
+	explanation := &Explanation{
+		Code:        "T0001",
+		Title:       "Test",
+		Description: "\nDescribes the test.",
+		Examples: []Example{
+			{Erroneous: "\nmethod run()\n\n  fail()", Corrected: "\nmethod run()"},
+		},
+	}
+	var output strings.Builder
+	if err := explanation.Write(&output); err != nil {
+		testing.Fatal(err)
+	}
+	expected := "T0001: Test\n\nDescribes the test.\n" +
+		"\nErroneous code example:\n\n    method run()\n\n      fail()\n" +
+		"\nCorrected code example:\n\n    method run()\n"
+	if output.String() != expected {
+		testing.Errorf("unexpected output %q, expected %q", output.String(), expected)
+	}
+}
+
+func TestBag_RecordsCodeOfError(testing *testing.T) {
+	bag := NewBag()
+	bag.Record(RecordedEntry{
+		Kind:     &Error,
+		Stage:    &SyntacticalAnalysis,
+		Error:    &RichError{Error: &UnexpectedTokenError{Expected: ")"}},
+		Position: input.Region{},
+	})
+	entries := bag.CreateDiagnostics(func(input.Offset) input.Position {
+		return input.Position{}
+	}).ListEntries()
+	if len(entries) != 1 || entries[0].Code != CodeUnexpectedToken {
+		testing.Errorf("expected the code of the error to be recorded")
+	}
+}
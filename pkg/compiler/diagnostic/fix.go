@@ -0,0 +1,88 @@
+package diagnostic
+
+// Fix is a change to the source of a unit, that resolves the cause of a
+// diagnostic. The edits of a fix must not overlap each other.
+type Fix struct {
+	Title string
+	Edits []Edit
+	// MachineApplicable is true if the fix is known to be correct. Such fixes
+	// are applied by tools without asking the user. Other fixes, like guessed
+	// replacements of misspelled names, are only suggested.
+	MachineApplicable bool
+}
+
+// Edit replaces the text at the position with the replacement. Edits with an
+// empty replacement remove the text.
+type Edit struct {
+	Position    RecordedPosition
+	Replacement string
+}
+
+// NewRemovalFix creates a fix that removes the text at the position.
+func NewRemovalFix(title string, position RecordedPosition) *Fix {
+	return &Fix{
+		Title: title,
+		Edits: []Edit{{Position: position}},
+	}
+}
+
+// NewReplacementFix creates a fix that replaces the text at the position.
+func NewReplacementFix(title string, position RecordedPosition, replacement string) *Fix {
+	return &Fix{
+		Title: title,
+		Edits: []Edit{{Position: position, Replacement: replacement}},
+	}
+}
+
+// PositionedFix is a fix, whose edits are located by lines and columns. The
+// edits change the unit of the entry that the fix is attached to.
+type PositionedFix struct {
+	Title             string
+	Edits             []PositionedEdit
+	MachineApplicable bool
+}
+
+type PositionedEdit struct {
+	Position    Position
+	Replacement string
+}
+
+// listFixes lists the fixes of the entry. The fix of the entry itself is
+// the preferred one and listed first, followed by the alternative fixes of
+// its error.
+func listFixes(recorded RecordedEntry) (fixes []*Fix) {
+	if recorded.Fix != nil {
+		fixes = append(fixes, recorded.Fix)
+	}
+	if recorded.Error == nil {
+		return fixes
+	}
+	for _, fix := range recorded.Error.Fixes {
+		if fix != recorded.Fix {
+			fixes = append(fixes, fix)
+		}
+	}
+	return fixes
+}
+
+func translateFixes(
+	converter OffsetConversionFunction, recorded RecordedEntry) (fixes []PositionedFix) {
+
+	for _, fix := range listFixes(recorded) {
+		positioned := PositionedFix{
+			Title:             fix.Title,
+			MachineApplicable: fix.MachineApplicable,
+		}
+		for _, edit := range fix.Edits {
+			positioned.Edits = append(positioned.Edits, PositionedEdit{
+				Position: Position{
+					Begin: converter(edit.Position.Begin()),
+					End:   converter(edit.Position.End()),
+				},
+				Replacement: edit.Replacement,
+			})
+		}
+		fixes = append(fixes, positioned)
+	}
+	return fixes
+}
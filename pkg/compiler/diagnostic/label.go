@@ -0,0 +1,30 @@
+package diagnostic
+
+// Label is a secondary region of an entry, that is related to its cause.
+// A name collision, for example, labels the declaration that the colliding
+// name was first declared in. Labels are located in the unit of their entry.
+type Label struct {
+	Message  string
+	Position RecordedPosition
+}
+
+// PositionedLabel is a label, whose region is located by lines and columns.
+type PositionedLabel struct {
+	Message  string
+	Position Position
+}
+
+func translateLabels(
+	converter OffsetConversionFunction, recorded RecordedEntry) (labels []PositionedLabel) {
+
+	for _, label := range recorded.Labels {
+		labels = append(labels, PositionedLabel{
+			Message: label.Message,
+			Position: Position{
+				Begin: converter(label.Position.Begin()),
+				End:   converter(label.Position.End()),
+			},
+		})
+	}
+	return labels
+}
@@ -0,0 +1,22 @@
+package diagnostic
+
+func init() {
+	RegisterMessages(DefaultLocale, map[Code]string{
+		CodeUnexpectedToken:                          "expected %s but got %s",
+		CodeUnexpectedToken.Qualify("nothing"):       "expected %s but got nothing",
+		CodeInvalidStatement:                         "invalid statement of kind %s",
+		CodeInvalidIndentation:                       "expected indent of %d but got %d",
+		CodeInvalidIndentation.Qualify("unindented"): "expected no indent but has %d",
+		CodeNameCollision:                            "collision for name %s",
+		CodeNameCollision.Qualify("declaration"):     "first declared here",
+	})
+	RegisterMessages("de", map[Code]string{
+		CodeUnexpectedToken:                          "%s wurde erwartet, aber %s gefunden",
+		CodeUnexpectedToken.Qualify("nothing"):       "%s wurde erwartet, aber nichts gefunden",
+		CodeInvalidStatement:                         "Ungültige Anweisung der Art %s",
+		CodeInvalidIndentation:                       "Einrückung von %d wurde erwartet, aber %d gefunden",
+		CodeInvalidIndentation.Qualify("unindented"): "Keine Einrückung wurde erwartet, aber %d gefunden",
+		CodeNameCollision:                            "Der Name %s ist bereits vergeben",
+		CodeNameCollision.Qualify("declaration"):     "hier zuerst deklariert",
+	})
+}
@@ -12,6 +12,14 @@ type RecordedEntry struct {
 	UnitName string
 	Error    *RichError
 	Position RecordedPosition
+	// Fix is an optional change, that resolves the cause of the entry. It is
+	// preferred over the alternative fixes of the entries error.
+	Fix *Fix
+	// Code is the stable code of the entry. It defaults to the code of the
+	// entries error.
+	Code Code
+	// Labels are secondary regions, that are related to the cause of the entry.
+	Labels []Label
 }
 
 type Bag struct {
@@ -33,6 +41,9 @@ func (recorder *Bag) Record(entry RecordedEntry) {
 	if entry.Message == "" {
 		entry.Message = entry.Error.Error.Name()
 	}
+	if entry.Code == "" && entry.Error != nil {
+		entry.Code = entry.Error.Error.Code()
+	}
 	*recorder.entries = append(*recorder.entries, entry)
 }
 
@@ -65,7 +76,10 @@ func translateEntry(
 		UnitName: recorded.UnitName,
 		Kind:     recorded.Kind,
 		Message:  recorded.Message,
+		Code:     recorded.Code,
 		Stage:    recorded.Stage,
 		Error:    recorded.Error,
+		Fixes:    translateFixes(converter, recorded),
+		Labels:   translateLabels(converter, recorded),
 	}
 }
\ No newline at end of file
@@ -1,25 +1,39 @@
 package diagnostic
 
 import (
-	"fmt"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
 )
 
 type RichError struct {
 	Error         KnownError
 	CommonReasons []string
+	// Fixes are alternative fixes, that each resolve the error.
+	Fixes []*Fix
 }
 
 type KnownError interface {
 	Name() string
+	// Code returns the stable code of the error.
+	Code() Code
 }
 
+const (
+	CodeUnexpectedToken    Code = "P0001"
+	CodeInvalidStatement   Code = "P0002"
+	CodeInvalidIndentation Code = "P0003"
+	CodeNameCollision      Code = "S0001"
+)
+
 type NameCollisionError struct {
 	Symbol string
 }
 
 func (error *NameCollisionError) Name() string {
-	return fmt.Sprintf("name %s collides with another entry in the same scope", error.Symbol)
+	return FormatMessage(CodeNameCollision, error.Symbol)
+}
+
+func (error *NameCollisionError) Code() Code {
+	return CodeNameCollision
 }
 
 type UnexpectedTokenError struct {
@@ -28,10 +42,14 @@ type UnexpectedTokenError struct {
 }
 
 func (error *UnexpectedTokenError) Name() string {
-	if error.Received != "" {
-		return fmt.Sprintf("expected %s but got nothing", error.Expected)
+	if error.Received == "" {
+		return FormatMessage(CodeUnexpectedToken.Qualify("nothing"), error.Expected)
 	}
-	return fmt.Sprintf("expected %s but got %s", error.Expected, error.Received)
+	return FormatMessage(CodeUnexpectedToken, error.Expected, error.Received)
+}
+
+func (error *UnexpectedTokenError) Code() Code {
+	return CodeUnexpectedToken
 }
 
 type InvalidStatementError struct {
@@ -39,7 +57,11 @@ type InvalidStatementError struct {
 }
 
 func (error *InvalidStatementError) Name() string {
-	return fmt.Sprintf("invalid statement of kind %s", error.Kind.Name())
+	return FormatMessage(CodeInvalidStatement, error.Kind.Name())
+}
+
+func (error *InvalidStatementError) Code() Code {
+	return CodeInvalidStatement
 }
 
 type InvalidIndentationError struct {
@@ -49,16 +71,26 @@ type InvalidIndentationError struct {
 
 func (error *InvalidIndentationError) Name() string {
 	if len(error.Expected) == 0 {
-		return fmt.Sprintf("expected no indent but has %d", error.Received)
+		return FormatMessage(CodeInvalidIndentation.Qualify("unindented"), error.Received)
 	}
-	return fmt.Sprintf(
-		"expected indent of %d but got %d", len(error.Expected), error.Received)
+	return FormatMessage(CodeInvalidIndentation, len(error.Expected), error.Received)
+}
+
+func (error *InvalidIndentationError) Code() Code {
+	return CodeInvalidIndentation
 }
 
+// SpecificError is an error, that is only reported at a single place. Its
+// code is assigned by the place, that reports it.
 type SpecificError struct {
-	Message string
+	Message   string
+	ErrorCode Code
 }
 
 func (error *SpecificError) Name() string {
 	return error.Message
 }
+
+func (error *SpecificError) Code() Code {
+	return error.ErrorCode
+}
@@ -0,0 +1,67 @@
+package lexical
+
+import "github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+
+const (
+	CodeInvalidToken         diagnostic.Code = "L0001"
+	CodeInvalidNumber        diagnostic.Code = "L0002"
+	CodeInvalidStringLiteral diagnostic.Code = "L0003"
+)
+
+// codeOfError returns the code of a diagnostic, that reports the error.
+func codeOfError(err error) diagnostic.Code {
+	switch err {
+	case errNoLeadingQuoteInString, errStringContainsLineFeed, errInvalidEscapedChar:
+		return CodeInvalidStringLiteral
+	}
+	if _, ok := err.(*unexpectedCharError); ok {
+		return CodeInvalidNumber
+	}
+	return CodeInvalidToken
+}
+
+func init() {
+	diagnostic.RegisterExplanations(
+		&diagnostic.Explanation{
+			Code:  CodeInvalidToken,
+			Title: "Invalid token",
+			Description: `
+The source contains characters, that do not form a valid token. This is the
+case for unknown operators and keywords, as well as for names which contain
+characters other than letters, digits and underscores.`,
+		},
+		&diagnostic.Explanation{
+			Code:  CodeInvalidNumber,
+			Title: "Invalid number",
+			Description: `
+A number literal contains a digit, that is not valid in its radix. Binary
+numbers are prefixed with 0b and may only contain the digits 0 and 1, while
+hexadecimal numbers are prefixed with 0x.`,
+			Examples: []diagnostic.Example{
+				{
+					Erroneous: `
+let mask = 0b1021`,
+					Corrected: `
+let mask = 0b1011`,
+				},
+			},
+		},
+		&diagnostic.Explanation{
+			Code:  CodeInvalidStringLiteral,
+			Title: "Invalid string literal",
+			Description: `
+A string literal has to be closed on the line, on which it begins. Special
+characters, like a line feed or a quote, have to be escaped with a backslash.
+Only the escape sequences, that are known to the compiler, may be used.`,
+			Examples: []diagnostic.Example{
+				{
+					Erroneous: `
+let greeting = "Hello
+World"`,
+					Corrected: `
+let greeting = "Hello\nWorld"`,
+				},
+			},
+		},
+	)
+}
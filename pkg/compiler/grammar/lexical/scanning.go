@@ -51,7 +51,10 @@ type Scanning struct {
 	// time a new line is added to the lineMapBuilder.
 	lineBeginOffset input.Offset
 	hasHitEndOfFile bool
-	lineBuffer      *strings.Builder
+	// hasSavedLastLine records whether the line, that is ended by the end of
+	// the file instead of a linefeed, has been saved to the lineMapBuilder.
+	hasSavedLastLine bool
+	lineBuffer       *strings.Builder
 }
 
 var beginOfFile = token.NewInvalidToken("BeginOfFile", token.Position{}, token.NoIndent)
@@ -113,7 +116,7 @@ func (scanning *Scanning) advance() {
 
 func (scanning *Scanning) maybeWriteCurrentCharacter() {
 	current := scanning.char()
-	if !current.IsLineFeed() {
+	if !current.IsLineFeed() && current != input.EndOfFile {
 		scanning.lineBuffer.WriteRune(rune(current))
 	}
 }
@@ -142,6 +145,7 @@ func (scanning *Scanning) scanNextToken() token.Token {
 // will first return an end-of-statement. There will never be two end-of-statements
 // at the end of a file.
 func (scanning *Scanning) createEndOfFile() token.Token {
+	scanning.saveLastLine()
 	if scanning.hasHitEndOfFile {
 		return token.EndOfFile
 	}
@@ -192,6 +196,15 @@ func (scanning *Scanning) saveCurrentLine() {
 	scanning.lineMapBuilder.Append(text, scanning.lineBeginOffset, length)
 }
 
+// saveLastLine saves the line, that is ended by the end of the file. It is
+// saved only once, even though the end of the file is hit multiple times.
+func (scanning *Scanning) saveLastLine() {
+	if !scanning.hasSavedLastLine {
+		scanning.hasSavedLastLine = true
+		scanning.saveCurrentLine()
+	}
+}
+
 const invalidLineBegin = 255
 
 func (scanning *Scanning) fixCurrentLine() string {
@@ -236,6 +249,7 @@ func (scanning *Scanning) reportError(err error) {
 		Kind:     &diagnostic.Error,
 		Stage:    &diagnostic.LexicalAnalysis,
 		Message:  err.Error(),
+		Code:     codeOfError(err),
 		Position: scanning.last.Position(),
 	})
 }
@@ -357,7 +371,7 @@ func (scanning *Scanning) createPositionToOffset(begin input.Offset) token.Posit
 
 func (scanning *Scanning) currentPosition() token.Position {
 	return token.Position{
-		BeginOffset: scanning.input.Index(),
+		BeginOffset: scanning.begin,
 		EndOffset:   scanning.offset(),
 	}
 }
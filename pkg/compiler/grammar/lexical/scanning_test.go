@@ -158,3 +158,39 @@ func createTextWithLineLengths(lengths []int) string {
 	}
 	return builder.String()
 }
+
+func TestScanning_PositionsTokensAtTheirBegin(test *testing.T) {
+	scanner := NewStringScanning("add(first, second)")
+	expected := map[string]token.Position{
+		"add":    {BeginOffset: 0, EndOffset: 3},
+		"first":  {BeginOffset: 4, EndOffset: 9},
+		"second": {BeginOffset: 11, EndOffset: 17},
+	}
+	for _, scanned := range scanRemaining(scanner) {
+		position, ok := expected[scanned.Value()]
+		if !ok {
+			continue
+		}
+		if scanned.Position() != position {
+			test.Errorf("token %s is at %s, expected %s",
+				scanned.Value(), scanned.Position(), position)
+		}
+	}
+}
+
+func TestScanning_RecordsLastLine(test *testing.T) {
+	entries := []string{"first\nsecond", "first\nsecond\n"}
+	for _, entry := range entries {
+		scanner := NewStringScanning(entry)
+		scanRemaining(scanner)
+		lines := scanner.NewLineMap()
+		if lines.LineCount() != 2 {
+			test.Errorf("%q has %d lines, expected 2", entry, lines.LineCount())
+		}
+		position := lines.PositionAtOffset(8)
+		if position.Line.Index != 2 || position.Line.Text != "second" {
+			test.Errorf("offset 8 of %q is at %+v, expected the second line",
+				entry, position.Line)
+		}
+	}
+}
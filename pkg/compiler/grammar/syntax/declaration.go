@@ -4,6 +4,7 @@ import (
 	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/token"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/input"
 )
 
 func (parsing *Parsing) parseImportStatementList() (imports []*tree.ImportStatement) {
@@ -125,6 +126,38 @@ func (parsing *Parsing) parseImplementStatement() tree.Node {
 	}
 }
 
+// parseUnionDeclaration parses the declaration of a union. Its cases are
+// written on separate lines, that are indented deeper than the declaration.
+func (parsing *Parsing) parseUnionDeclaration() tree.Node {
+	parsing.beginStructure(tree.UnionDeclarationNodeKind)
+	parsing.skipKeyword(token.TypeKeyword)
+	name := parsing.parseIdentifier()
+	var cases []*tree.UnionCase
+	parsing.parseIndentedLines(func() {
+		cases = append(cases, parsing.parseUnionCase())
+	})
+	return &tree.UnionDeclaration{
+		Name:   name,
+		Cases:  cases,
+		Region: parsing.completeStructure(tree.UnionDeclarationNodeKind),
+	}
+}
+
+func (parsing *Parsing) parseUnionCase() *tree.UnionCase {
+	name := parsing.parseIdentifier()
+	var payload tree.ParameterList
+	if token.HasOperatorValue(parsing.token(), token.LeftParenOperator) {
+		payload = parsing.parseParameterListWithParens()
+	}
+	region := input.CreateRegion(name.Region.Begin(), parsing.offset())
+	parsing.skipEndOfStatement()
+	return &tree.UnionCase{
+		Name:    name,
+		Payload: payload,
+		Region:  region,
+	}
+}
+
 func (parsing *Parsing) parseTypeNameList() (names []tree.TypeName) {
 	names = append(names, parsing.parseTypeName())
 	for parsing.isLookingAtOperator(token.CommaOperator) {
@@ -0,0 +1,52 @@
+package syntax
+
+import "github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+
+const (
+	CodeMissingParameterName    diagnostic.Code = "P0101"
+	CodeMisplacedContractClause diagnostic.Code = "P0102"
+)
+
+func init() {
+	diagnostic.RegisterExplanations(
+		&diagnostic.Explanation{
+			Code:  CodeMissingParameterName,
+			Title: "Missing parameter name",
+			Description: `
+Every parameter of a method is declared by its name, followed by its type.
+The name is also the label of the argument, that is passed for the parameter.`,
+			Examples: []diagnostic.Example{
+				{
+					Erroneous: `
+method square(Number) returns Number
+  return number * number`,
+					Corrected: `
+method square(number Number) returns Number
+  return number * number`,
+				},
+			},
+		},
+		&diagnostic.Explanation{
+			Code:  CodeMisplacedContractClause,
+			Title: "Contract clause outside of a method",
+			Description: `
+The requires and ensures clauses declare the contract of a method. They have
+to be the first statements in the body of the method, that they belong to.
+Clauses outside of a method are most commonly not indented into its body.
+Clauses that follow other statements of the body, or that are nested in
+one of its blocks, are not part of the contract and are reported as well.`,
+			Examples: []diagnostic.Example{
+				{
+					Erroneous: `
+method divide(left Number, right Number) returns Number
+requires right isnt 0
+  return left / right`,
+					Corrected: `
+method divide(left Number, right Number) returns Number
+  requires right isnt 0
+  return left / right`,
+				},
+			},
+		},
+	)
+}
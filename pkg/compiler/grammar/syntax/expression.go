@@ -38,11 +38,11 @@ func (parsing *Parsing) parseUnaryExpression() tree.Expression {
 		return parsing.parseCreateExpression()
 	}
 	if !token.IsOperatorOrOperatorKeywordToken(operatorToken) {
-		return parsing.parseOperation()
+		return parsing.parseOperationWithOptionalPropagation()
 	}
 	operator := token.OperatorValue(operatorToken)
 	if !operator.IsUnaryOperator() {
-		return parsing.parseOperation()
+		return parsing.parseOperationWithOptionalPropagation()
 	}
 	parsing.advance()
 	operand := parsing.parseUnaryExpression()
@@ -53,6 +53,28 @@ func (parsing *Parsing) parseUnaryExpression() tree.Expression {
 	}
 }
 
+// parseOperationWithOptionalPropagation parses an operation that may be
+// followed by the propagation operator '?'. The operator unwraps the value
+// of a Result or returns its failure from the enclosing method.
+// Example: 'parseNumber(text)?'
+func (parsing *Parsing) parseOperationWithOptionalPropagation() tree.Expression {
+	operation := parsing.parseOperation()
+	if token.HasOperatorValue(parsing.token(), token.QuestionMarkOperator) {
+		return parsing.completePropagationExpression(operation)
+	}
+	return operation
+}
+
+func (parsing *Parsing) completePropagationExpression(
+	operand tree.Expression) tree.Expression {
+
+	parsing.advance()
+	return &tree.PropagationExpression{
+		Operand: operand,
+		Region:  parsing.createRegionOfCurrentStructure(),
+	}
+}
+
 // parseBinaryExpression parses a binary expression. Binary expressions are
 // operations with two operands. Strict uses the infix notation, therefor
 // binary expressions have a left-hand-side and right-hand-side operand and
@@ -99,6 +121,8 @@ func (parsing *Parsing) parseFirstOperand() tree.Expression {
 		return parsing.parseIdentifier()
 	case token.HasOperatorValue(last, token.LeftBracketOperator):
 		return parsing.parseListExpression()
+	case token.HasOperatorValue(last, token.LeftCurlyOperator):
+		return parsing.parseMapExpression()
 	case token.IsStringLiteralToken(last):
 		return parsing.parseStringLiteral()
 	case token.IsNumberLiteralToken(last):
@@ -274,6 +298,39 @@ func (parsing *Parsing) parseListExpression() *tree.ListExpression {
 	}
 }
 
+// parseMapExpression parses a map literal. The entries of the literal are
+// separated by commas and each entry has a key and a value, which are
+// separated by a colon. Example: '{"one": 1, "two": 2}'
+func (parsing *Parsing) parseMapExpression() *tree.MapExpression {
+	parsing.beginStructure(tree.MapExpressionNodeKind)
+	parsing.skipOperator(token.LeftCurlyOperator)
+	var entries []*tree.MapEntry
+	if !token.HasOperatorValue(parsing.token(), token.RightCurlyOperator) {
+		entries = parsing.parseCommaSeparatedMapEntries()
+	}
+	parsing.skipOperator(token.RightCurlyOperator)
+	return &tree.MapExpression{
+		Entries: entries,
+		Region:  parsing.completeStructure(tree.MapExpressionNodeKind),
+	}
+}
+
+func (parsing *Parsing) parseCommaSeparatedMapEntries() []*tree.MapEntry {
+	entries := []*tree.MapEntry{parsing.parseMapEntry()}
+	for token.HasOperatorValue(parsing.token(), token.CommaOperator) {
+		parsing.skipOperator(token.CommaOperator)
+		entries = append(entries, parsing.parseMapEntry())
+	}
+	return entries
+}
+
+func (parsing *Parsing) parseMapEntry() *tree.MapEntry {
+	key := parsing.parseExpression()
+	parsing.skipOperator(token.ColonOperator)
+	value := parsing.parseExpression()
+	return &tree.MapEntry{Key: key, Value: value}
+}
+
 func (parsing *Parsing) parseCommaSeparatedExpressions() []tree.Expression {
 	expressions := []tree.Expression{parsing.parseExpression()}
 	for token.HasOperatorValue(parsing.token(), token.CommaOperator) {
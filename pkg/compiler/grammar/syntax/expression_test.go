@@ -1,8 +1,10 @@
 package syntax
 
 import (
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/lexical"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/token"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/input"
 	"testing"
 )
 
@@ -262,3 +264,86 @@ func TestParsing_ParseCallExpression(testing *testing.T) {
 			return parsing.parseExpression()
 		})
 }
+
+func TestParsing_ParsePropagationExpression(testing *testing.T) {
+	ExpectAllResults(testing,
+		[]ParserTestEntry{
+			{
+				Input: `parse(text)?`,
+				ExpectedOutput: &tree.PropagationExpression{
+					Operand: &tree.CallExpression{
+						Target: &tree.Identifier{Value: "parse"},
+						Arguments: tree.CallArgumentList{
+							&tree.CallArgument{
+								Value: &tree.Identifier{Value: "text"},
+							},
+						},
+					},
+				},
+			},
+			{
+				Input: `reader.Read()? + 1`,
+				ExpectedOutput: &tree.BinaryExpression{
+					Operator: token.AddOperator,
+					LeftOperand: &tree.PropagationExpression{
+						Operand: &tree.ChainExpression{
+							Expressions: []tree.Expression{
+								&tree.Identifier{Value: "reader"},
+								&tree.CallExpression{
+									Target: &tree.Identifier{Value: "Read"},
+								},
+							},
+						},
+					},
+					RightOperand: &tree.NumberLiteral{Value: "1"},
+				},
+			},
+		},
+		func(parsing *Parsing) tree.Node {
+			return parsing.parseExpression()
+		})
+}
+
+func TestParseMapExpression(testing *testing.T) {
+	ExpectAllResults(testing,
+		[]ParserTestEntry{
+			{
+				Input:          `{}`,
+				ExpectedOutput: &tree.MapExpression{},
+			},
+			{
+				Input: `{"one": 1, key: a + b}`,
+				ExpectedOutput: &tree.MapExpression{
+					Entries: []*tree.MapEntry{
+						{
+							Key:   &tree.StringLiteral{Value: `one`},
+							Value: &tree.NumberLiteral{Value: `1`},
+						},
+						{
+							Key: &tree.Identifier{Value: `key`},
+							Value: &tree.BinaryExpression{
+								LeftOperand:  &tree.Identifier{Value: `a`},
+								RightOperand: &tree.Identifier{Value: `b`},
+								Operator:     token.AddOperator,
+							},
+						},
+					},
+				},
+			},
+		},
+		func(parsing *Parsing) tree.Node {
+			return parsing.parseExpression()
+		})
+}
+
+func TestParsing_ParseIdentifierCoversItsToken(testing *testing.T) {
+	tokens := lexical.NewStringScanning("  total")
+	parser := NewTestParser(tokens)
+	identifier := parser.parseIdentifier()
+	expected := input.CreateRegion(2, 7)
+	if identifier.Region != expected {
+		testing.Errorf("identifier is in region %v, expected %v",
+			identifier.Region, expected)
+	}
+	expectEmptyStructureStack(testing, parser)
+}
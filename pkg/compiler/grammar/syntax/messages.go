@@ -0,0 +1,22 @@
+package syntax
+
+import "github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+
+func init() {
+	diagnostic.RegisterMessages(diagnostic.DefaultLocale, map[diagnostic.Code]string{
+		CodeMissingParameterName:                      "Name of the parameter is missing",
+		CodeMissingParameterName.Qualify("reason"):    "The parameters type was not specified prior to the name",
+		CodeMisplacedContractClause:                   "%s clause is declared outside of a method",
+		CodeMisplacedContractClause.Qualify("reason"): "The clause is not indented into the methods body",
+		CodeMisplacedContractClause.Qualify("body"):   "%s clause is declared after other statements of the method",
+		CodeMisplacedContractClause.Qualify("order"):  "Contract clauses have to precede all other statements of the methods body",
+	})
+	diagnostic.RegisterMessages("de", map[diagnostic.Code]string{
+		CodeMissingParameterName:                      "Der Name des Parameters fehlt",
+		CodeMissingParameterName.Qualify("reason"):    "Der Typ des Parameters wurde nicht vor seinem Namen angegeben",
+		CodeMisplacedContractClause:                   "Die %s-Klausel ist außerhalb einer Methode deklariert",
+		CodeMisplacedContractClause.Qualify("reason"): "Die Klausel ist nicht in den Rumpf der Methode eingerückt",
+		CodeMisplacedContractClause.Qualify("body"):   "Die %s-Klausel ist nach anderen Anweisungen der Methode deklariert",
+		CodeMisplacedContractClause.Qualify("order"):  "Vertragsklauseln müssen vor allen anderen Anweisungen des Methodenrumpfs stehen",
+	})
+}
@@ -20,10 +20,12 @@ func (parsing *Parsing) parseMethodDeclaration() *tree.MethodDeclaration {
 	signature := parsing.parseMethodSignature()
 	parsing.updateCurrentMethod(signature)
 	body := parsing.parseMethodBody()
+	parsing.reportMisplacedContractClauses(body)
 	return &tree.MethodDeclaration{
 		Type:       signature.returnTypeName,
 		Name:       signature.name,
 		Parameters: signature.parameters,
+		Extended:   signature.extended,
 		Abstract:   len(body.Children) == 0,
 		Body:       body,
 		Region:     parsing.completeStructure(tree.MethodDeclarationNodeKind),
@@ -45,6 +47,7 @@ func isVoidType(name tree.TypeName) bool {
 }
 
 type methodSignature struct {
+	extended       tree.TypeName
 	name           *tree.Identifier
 	parameters     tree.ParameterList
 	returnTypeName tree.TypeName
@@ -61,12 +64,30 @@ func (parsing *Parsing) parseMethodBlockBody() *tree.StatementBlock {
 
 func (parsing *Parsing) parseMethodSignature() methodSignature {
 	return methodSignature{
+		extended:       parsing.parseOptionalExtendedTypeName(),
 		name:           parsing.parseIdentifier(),
 		parameters:     parsing.parseParameterListWithParens(),
 		returnTypeName: parsing.parseOptionalReturnTypeName(),
 	}
 }
 
+// parseOptionalExtendedTypeName parses the name of the class that is extended
+// by an extension method. Extension methods are declared by prefixing the
+// methods name with the extended class: `method String.shout() returns String`.
+func (parsing *Parsing) parseOptionalExtendedTypeName() tree.TypeName {
+	if !parsing.isLookingAtExtendedTypeName() {
+		return nil
+	}
+	typeName := parsing.parseTypeName()
+	parsing.skipOperator(token.DotOperator)
+	return typeName
+}
+
+func (parsing *Parsing) isLookingAtExtendedTypeName() bool {
+	return token.IsIdentifierToken(parsing.token()) &&
+		token.HasOperatorValue(parsing.peek(), token.DotOperator)
+}
+
 func (parsing *Parsing) parseOptionalReturnTypeName() tree.TypeName {
 	if !token.HasKeywordValue(parsing.token(), token.ReturnsKeyword) {
 		return &tree.ConcreteTypeName{
@@ -169,10 +190,11 @@ func (parsing *Parsing) parseParameterName() *tree.Identifier {
 func newMissingParameterNameError() *diagnostic.RichError {
 	return &diagnostic.RichError{
 		Error: &diagnostic.SpecificError{
-			Message: "Name of the parameter is missing",
+			Message:   diagnostic.FormatMessage(CodeMissingParameterName),
+			ErrorCode: CodeMissingParameterName,
 		},
 		CommonReasons: []string{
-			"The parameters type was not specified prior to the name",
+			diagnostic.FormatMessage(CodeMissingParameterName.Qualify("reason")),
 		},
 	}
 }
@@ -1,6 +1,8 @@
 package syntax
 
 import (
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/lexical"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/token"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
 	"github.com/strict-lang/sdk/pkg/compiler/input"
 	"strings"
@@ -109,6 +111,80 @@ method printList(numbers Number[])
 		})
 }
 
+func TestParsing_ParseExtensionMethodDeclaration(testing *testing.T) {
+	ExpectResult(testing, `
+method String.shout() returns String
+  return self
+`,
+		&tree.MethodDeclaration{
+			Name:       &tree.Identifier{Value: `shout`},
+			Type:       &tree.ConcreteTypeName{Name: `String`},
+			Extended:   &tree.ConcreteTypeName{Name: `String`},
+			Parameters: tree.ParameterList{},
+			Body: &tree.StatementBlock{
+				Children: []tree.Statement{
+					&tree.ReturnStatement{
+						Value: &tree.Identifier{Value: `self`},
+					},
+				},
+			},
+		}, func(parsing *Parsing) tree.Node {
+			return parsing.parseMethodDeclaration()
+		})
+}
+
+func TestParsing_ParseMethodContract(testing *testing.T) {
+	ExpectResult(testing, `
+method divide(left Number, right Number) returns Number
+  requires right isnt 0
+  ensures result < left
+  return left / right
+`,
+		&tree.MethodDeclaration{
+			Name: &tree.Identifier{Value: `divide`},
+			Type: &tree.ConcreteTypeName{Name: `Number`},
+			Parameters: tree.ParameterList{
+				&tree.Parameter{
+					Type: &tree.ConcreteTypeName{Name: `Number`},
+					Name: &tree.Identifier{Value: `left`},
+				},
+				&tree.Parameter{
+					Type: &tree.ConcreteTypeName{Name: `Number`},
+					Name: &tree.Identifier{Value: `right`},
+				},
+			},
+			Body: &tree.StatementBlock{
+				Children: []tree.Statement{
+					&tree.AssertStatement{
+						Kind: tree.Precondition,
+						Expression: &tree.BinaryExpression{
+							Operator:     token.NotEqualsOperator,
+							LeftOperand:  &tree.Identifier{Value: `right`},
+							RightOperand: &tree.NumberLiteral{Value: `0`},
+						},
+					},
+					&tree.AssertStatement{
+						Kind: tree.Postcondition,
+						Expression: &tree.BinaryExpression{
+							Operator:     token.SmallerOperator,
+							LeftOperand:  &tree.Identifier{Value: `result`},
+							RightOperand: &tree.Identifier{Value: `left`},
+						},
+					},
+					&tree.ReturnStatement{
+						Value: &tree.BinaryExpression{
+							Operator:     token.DivOperator,
+							LeftOperand:  &tree.Identifier{Value: `left`},
+							RightOperand: &tree.Identifier{Value: `right`},
+						},
+					},
+				},
+			},
+		}, func(parsing *Parsing) tree.Node {
+			return parsing.parseMethodDeclaration()
+		})
+}
+
 func TestParsing_InvalidMethodDeclaration(testing *testing.T) {
 	ExpectError(testing,
 		`method call(x Number`,
@@ -127,3 +203,56 @@ func TestParsing_InvalidMethodDeclaration(testing *testing.T) {
 			return strings.HasSuffix(err.Error(), "expected ) but got: 'eof'")
 		})
 }
+
+func TestParsing_ReportsMisplacedContractClauses(testing *testing.T) {
+	entries := []struct {
+		code     string
+		expected int
+	}{
+		{code: `
+method divide(left Number, right Number) returns Number
+  requires right isnt 0
+  ensures result < left
+  return left / right
+`, expected: 0},
+		{code: `
+method divide(left Number, right Number) returns Number
+  let quotient = left / right
+  ensures result < left
+  return quotient
+`, expected: 1},
+		{code: `
+method divide(left Number, right Number) returns Number
+  if left > 0
+    requires right isnt 0
+  return left / right
+`, expected: 1},
+	}
+	for _, entry := range entries {
+		reported := countMisplacedContractClauses(testing, entry.code)
+		if reported != entry.expected {
+			testing.Errorf("expected %d misplaced clauses in %s but got %d",
+				entry.expected, entry.code, reported)
+		}
+	}
+}
+
+func countMisplacedContractClauses(testing *testing.T, code string) (count int) {
+	tokens := lexical.NewStringScanning(code)
+	parser, bag := NewTestParserAndDiagnosticBag(tokens)
+	defer func() {
+		if failure := recover(); failure != nil {
+			testing.Errorf("%s", failure)
+		}
+	}()
+	parser.parseMethodDeclaration()
+	diagnostics := bag.CreateDiagnostics(func(input.Offset) input.Position {
+		return input.Position{}
+	})
+	for _, entry := range diagnostics.ListEntries() {
+		if entry.Code == CodeMisplacedContractClause {
+			count++
+		}
+	}
+	return count
+}
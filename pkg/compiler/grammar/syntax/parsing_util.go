@@ -4,6 +4,7 @@ import (
 	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/token"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/input"
 )
 
 // skipOperator skips the next keyword if it the passed operator, otherwise
@@ -43,16 +44,17 @@ func (parsing *Parsing) expectKeyword(expected token.Keyword) *diagnostic.RichEr
 }
 
 // expectAnyIdentifier expects the next token to be an identifier,
-// without regards to its value and returns an error if it fails.
+// without regards to its value and returns an error if it fails. The
+// identifiers region is the region of its token.
 func (parsing *Parsing) expectAnyIdentifier() *tree.Identifier {
-	parsing.beginStructure(tree.IdentifierNodeKind)
 	current := parsing.token()
 	if !token.IsIdentifierToken(current) {
 		parsing.throwError(newNoIdentifierError(current))
 	}
+	position := current.Position()
 	return &tree.Identifier{
 		Value:  current.Value(),
-		Region: parsing.completeStructure(tree.IdentifierNodeKind),
+		Region: input.CreateRegion(position.Begin(), position.End()),
 	}
 }
 
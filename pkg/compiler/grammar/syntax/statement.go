@@ -34,6 +34,12 @@ func init() {
 		token.AssertKeyword: func(parsing *Parsing) tree.Node {
 			return parsing.parseAssertStatement()
 		},
+		token.RequiresKeyword: func(parsing *Parsing) tree.Node {
+			return parsing.parseContractClause(token.RequiresKeyword, tree.Precondition)
+		},
+		token.EnsuresKeyword: func(parsing *Parsing) tree.Node {
+			return parsing.parseContractClause(token.EnsuresKeyword, tree.Postcondition)
+		},
 		token.TestKeyword: func(parsing *Parsing) tree.Node {
 			return parsing.parseTestStatement()
 		},
@@ -43,6 +49,12 @@ func init() {
 		token.LetKeyword: func(parsing *Parsing) tree.Node {
 			return parsing.parseLetBindingStatement()
 		},
+		token.TypeKeyword: func(parsing *Parsing) tree.Node {
+			return parsing.parseUnionDeclaration()
+		},
+		token.MatchKeyword: func(parsing *Parsing) tree.Node {
+			return parsing.parseMatchStatement()
+		},
 		token.ImplementKeyword: func(parsing *Parsing) tree.Node {
 			return parsing.parseImplementStatement()
 		},
@@ -147,6 +159,33 @@ func newInvalidIndentError(expected, received token.Indent) *diagnostic.RichErro
 	}
 }
 
+// parseIndentedLines calls the function for every line of the block that
+// follows the current line. It is used to parse blocks, whose lines are not
+// statements, like the cases of a union or the arms of a match.
+func (parsing *Parsing) parseIndentedLines(parseLine func()) {
+	parsing.skipEndOfStatement()
+	indent := parsing.token().Indent()
+	if indent <= parsing.block.Indent {
+		parsing.throwError(newSmallerIndentError(indent))
+	}
+	parsing.openBlock(indent)
+	defer parsing.closeBlock()
+	for !token.IsEndOfFileToken(parsing.token()) {
+		current := parsing.token()
+		if token.IsEndOfStatementToken(current) {
+			parsing.advance()
+			continue
+		}
+		if current.Indent() < indent {
+			return
+		}
+		if current.Indent() > indent {
+			parsing.throwError(newInvalidIndentError(indent, current.Indent()))
+		}
+		parseLine()
+	}
+}
+
 // ParseStatementBlock parses a block of statements.
 func (parsing *Parsing) parseStatementBlock() *tree.StatementBlock {
 	parsing.beginStructure(tree.StatementBlockNodeKind)
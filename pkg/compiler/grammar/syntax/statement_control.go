@@ -1,8 +1,10 @@
 package syntax
 
 import (
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/token"
 	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/input"
 )
 
 // parseConditionalStatement parses a conditional statement and it's optional else-clause.
@@ -103,6 +105,86 @@ func (parsing *Parsing) parseAssertStatement() tree.Node {
 	}
 }
 
+// parseContractClause parses a `requires` or `ensures` clause, which are part
+// of a methods contract. They are represented as assertions of the given kind.
+func (parsing *Parsing) parseContractClause(
+	keyword token.Keyword, kind tree.AssertionKind) tree.Node {
+
+	parsing.beginStructure(tree.AssertStatementNodeKind)
+	if !parsing.isParsingMethod() {
+		parsing.throwError(newContractClauseOutsideOfMethodError(keyword))
+	}
+	parsing.skipKeyword(keyword)
+	expression := parsing.parseExpression()
+	parsing.skipEndOfStatement()
+	return &tree.AssertStatement{
+		Region:     parsing.completeStructure(tree.AssertStatementNodeKind),
+		Expression: expression,
+		Kind:       kind,
+	}
+}
+
+func newContractClauseOutsideOfMethodError(keyword token.Keyword) *diagnostic.RichError {
+	return &diagnostic.RichError{
+		Error: &diagnostic.SpecificError{
+			Message:   diagnostic.FormatMessage(CodeMisplacedContractClause, keyword),
+			ErrorCode: CodeMisplacedContractClause,
+		},
+		CommonReasons: []string{
+			diagnostic.FormatMessage(CodeMisplacedContractClause.Qualify("reason")),
+		},
+	}
+}
+
+// reportMisplacedContractClauses reports every contract clause of the body
+// that is not part of the methods contract. The contract consists of the
+// clauses that precede all other statements of the body. Clauses that are
+// declared after another statement or inside of a nested block are reported
+// instead of being dropped silently.
+func (parsing *Parsing) reportMisplacedContractClauses(body *tree.StatementBlock) {
+	for index, child := range body.Children {
+		if !isContractClause(child) {
+			parsing.reportContractClausesIn(body.Children[index:])
+			return
+		}
+	}
+}
+
+func (parsing *Parsing) reportContractClausesIn(statements []tree.Statement) {
+	visitor := tree.NewEmptyVisitor()
+	visitor.AssertStatementVisitor = func(clause *tree.AssertStatement) {
+		if clause.IsContractClause() {
+			parsing.reportError(newMisplacedContractClauseError(clause), clause.Region)
+		}
+	}
+	for _, statement := range statements {
+		statement.AcceptRecursive(visitor)
+	}
+}
+
+func isContractClause(node tree.Node) bool {
+	clause, isAssert := node.(*tree.AssertStatement)
+	return isAssert && clause.IsContractClause()
+}
+
+var contractClauseKeywords = map[tree.AssertionKind]token.Keyword{
+	tree.Precondition:  token.RequiresKeyword,
+	tree.Postcondition: token.EnsuresKeyword,
+}
+
+func newMisplacedContractClauseError(clause *tree.AssertStatement) *diagnostic.RichError {
+	keyword := contractClauseKeywords[clause.Kind]
+	return &diagnostic.RichError{
+		Error: &diagnostic.SpecificError{
+			Message:   diagnostic.FormatMessage(CodeMisplacedContractClause.Qualify("body"), keyword),
+			ErrorCode: CodeMisplacedContractClause,
+		},
+		CommonReasons: []string{
+			diagnostic.FormatMessage(CodeMisplacedContractClause.Qualify("order")),
+		},
+	}
+}
+
 func (parsing *Parsing) parseReturnStatement() *tree.ReturnStatement {
 	parsing.beginStructure(tree.ReturnStatementNodeKind)
 	parsing.skipKeyword(token.ReturnKeyword)
@@ -119,3 +201,51 @@ func (parsing *Parsing) parseReturnStatement() *tree.ReturnStatement {
 		Region: parsing.completeStructure(tree.ReturnStatementNodeKind),
 	}
 }
+
+// parseMatchStatement parses a match and its arms. Every arm names a case,
+// optionally followed by the names that its payload is bound to, or is the
+// default arm, which is introduced by the else keyword.
+func (parsing *Parsing) parseMatchStatement() tree.Node {
+	parsing.beginStructure(tree.MatchStatementNodeKind)
+	parsing.skipKeyword(token.MatchKeyword)
+	subject := parsing.parseExpression()
+	var arms []*tree.MatchArm
+	parsing.parseIndentedLines(func() {
+		arms = append(arms, parsing.parseMatchArm())
+	})
+	return &tree.MatchStatement{
+		Subject: subject,
+		Arms:    arms,
+		Region:  parsing.completeStructure(tree.MatchStatementNodeKind),
+	}
+}
+
+func (parsing *Parsing) parseMatchArm() *tree.MatchArm {
+	begin := parsing.offset()
+	arm := &tree.MatchArm{}
+	if token.HasKeywordValue(parsing.token(), token.ElseKeyword) {
+		parsing.advance()
+	} else {
+		arm.Case = parsing.parseIdentifier()
+		arm.Bindings = parsing.parseOptionalMatchBindings()
+	}
+	parsing.skipEndOfStatement()
+	arm.Body = parsing.parseStatementBlock()
+	arm.Region = input.CreateRegion(begin, parsing.offset())
+	return arm
+}
+
+func (parsing *Parsing) parseOptionalMatchBindings() (bindings []*tree.Identifier) {
+	if !token.HasOperatorValue(parsing.token(), token.LeftParenOperator) {
+		return bindings
+	}
+	parsing.skipOperator(token.LeftParenOperator)
+	for !token.HasOperatorValue(parsing.token(), token.RightParenOperator) {
+		if len(bindings) != 0 {
+			parsing.skipOperator(token.CommaOperator)
+		}
+		bindings = append(bindings, parsing.parseIdentifier())
+	}
+	parsing.skipOperator(token.RightParenOperator)
+	return bindings
+}
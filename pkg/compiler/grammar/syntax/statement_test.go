@@ -80,6 +80,110 @@ else
 		})
 }
 
+func TestParsing_ParseMatchStatement(testing *testing.T) {
+	ExpectAllResults(testing,
+		[]ParserTestEntry{
+			{
+				Input: `
+match shape
+  Circle(radius)
+    return radius
+  Rectangle(width, height)
+    return width
+  else
+    return 0
+`,
+				ExpectedOutput: &tree.MatchStatement{
+					Subject: &tree.Identifier{Value: `shape`},
+					Arms: []*tree.MatchArm{
+						{
+							Case:     &tree.Identifier{Value: `Circle`},
+							Bindings: []*tree.Identifier{{Value: `radius`}},
+							Body: &tree.StatementBlock{
+								Children: []tree.Statement{
+									&tree.ReturnStatement{
+										Value: &tree.Identifier{Value: `radius`},
+									},
+								},
+							},
+						},
+						{
+							Case: &tree.Identifier{Value: `Rectangle`},
+							Bindings: []*tree.Identifier{
+								{Value: `width`},
+								{Value: `height`},
+							},
+							Body: &tree.StatementBlock{
+								Children: []tree.Statement{
+									&tree.ReturnStatement{
+										Value: &tree.Identifier{Value: `width`},
+									},
+								},
+							},
+						},
+						{
+							Body: &tree.StatementBlock{
+								Children: []tree.Statement{
+									&tree.ReturnStatement{
+										Value: &tree.NumberLiteral{Value: `0`},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, func(parsing *Parsing) tree.Node {
+			return parsing.parseStatement()
+		})
+}
+
+func TestParsing_ParseUnionDeclaration(testing *testing.T) {
+	ExpectAllResults(testing,
+		[]ParserTestEntry{
+			{
+				Input: `
+type Shape
+  Circle(radius Number)
+  Rectangle(width Number, height Number)
+  Empty
+`,
+				ExpectedOutput: &tree.UnionDeclaration{
+					Name: &tree.Identifier{Value: `Shape`},
+					Cases: []*tree.UnionCase{
+						{
+							Name: &tree.Identifier{Value: `Circle`},
+							Payload: tree.ParameterList{
+								{
+									Name: &tree.Identifier{Value: `radius`},
+									Type: &tree.ConcreteTypeName{Name: `Number`},
+								},
+							},
+						},
+						{
+							Name: &tree.Identifier{Value: `Rectangle`},
+							Payload: tree.ParameterList{
+								{
+									Name: &tree.Identifier{Value: `width`},
+									Type: &tree.ConcreteTypeName{Name: `Number`},
+								},
+								{
+									Name: &tree.Identifier{Value: `height`},
+									Type: &tree.ConcreteTypeName{Name: `Number`},
+								},
+							},
+						},
+						{
+							Name: &tree.Identifier{Value: `Empty`},
+						},
+					},
+				},
+			},
+		}, func(parsing *Parsing) tree.Node {
+			return parsing.parseStatement()
+		})
+}
+
 func TestParsing_ParseReturnStatement(testing *testing.T) {
 	ExpectAllResults(testing,
 		[]ParserTestEntry{
@@ -35,6 +35,9 @@ const (
 	ReturnsKeyword
 	HasKeyword
 	ExistsKeyword
+	RequiresKeyword
+	EnsuresKeyword
+	MatchKeyword
 )
 
 var keywordNameTable = map[Keyword]string{
@@ -64,6 +67,9 @@ var keywordNameTable = map[Keyword]string{
 	AssertKeyword:    "assert",
 	CreateKeyword:    "create",
 	ExistsKeyword:    "exists",
+	RequiresKeyword:  "requires",
+	EnsuresKeyword:   "ensures",
+	MatchKeyword:     "match",
 }
 
 var operatorKeywords = map[Keyword]Operator{
@@ -5,9 +5,35 @@ import "github.com/strict-lang/sdk/pkg/compiler/input"
 type AssertStatement struct {
 	Region     input.Region
 	Expression Expression
+	Kind       AssertionKind
 	Parent     Node
 }
 
+// AssertionKind is the kind of an AssertStatement. Next to plain assertions,
+// the contract of a method is declared with assertions. Preconditions are
+// checked when the method is entered and postconditions when it is left.
+type AssertionKind int
+
+const (
+	Assertion AssertionKind = iota
+	Precondition
+	Postcondition
+)
+
+var assertionKindNames = map[AssertionKind]string{
+	Assertion:     "assertion",
+	Precondition:  "precondition",
+	Postcondition: "postcondition",
+}
+
+func (kind AssertionKind) String() string {
+	return assertionKindNames[kind]
+}
+
+func (assert *AssertStatement) IsContractClause() bool {
+	return assert.Kind != Assertion
+}
+
 func (assert *AssertStatement) SetEnclosingNode(target Node) {
 	assert.Parent = target
 }
@@ -31,7 +57,8 @@ func (assert *AssertStatement) Locate() input.Region {
 
 func (assert *AssertStatement) Matches(node Node) bool {
 	if target, ok := node.(*AssertStatement); ok {
-		return assert.Expression.Matches(target.Expression)
+		return assert.Kind == target.Kind &&
+			assert.Expression.Matches(target.Expression)
 	}
 	return false
 }
@@ -66,6 +66,18 @@ func (class *ClassDeclaration) AcceptRecursive(visitor Visitor) {
 	}
 }
 
+// ImplementedTraits returns the names of the traits, that the class implements.
+// Traits are implemented with `implement` or by listing them as super types.
+func (class *ClassDeclaration) ImplementedTraits() []TypeName {
+	traits := append([]TypeName{}, class.SuperTypes...)
+	for _, child := range class.Children {
+		if statement, ok := child.(*ImplementStatement); ok {
+			traits = append(traits, statement.Trait)
+		}
+	}
+	return traits
+}
+
 func (class *ClassDeclaration) Locate() input.Region {
 	return class.Region
 }
@@ -122,8 +134,5 @@ func (class *ClassDeclaration) hasChildren(children []Node) bool {
 
 func (class *ClassDeclaration) NewActualClass() typing.Type {
 	// TODO: Create proper class
-	return &typing.ConcreteType{
-		Name:   class.Name,
-		Traits: []typing.Type{},
-	}
+	return &typing.ConcreteType{Name: class.Name}
 }
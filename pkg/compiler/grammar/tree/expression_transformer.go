@@ -2,6 +2,7 @@ package tree
 
 type ExpressionTransformer interface {
 	RewriteListExpression(*ListExpression) Expression
+	RewriteMapExpression(*MapExpression) Expression
 	RewriteIdentifier(*Identifier) Expression
 	RewriteStringLiteral(*StringLiteral) Expression
 	RewriteNumberLiteral(*NumberLiteral) Expression
@@ -10,6 +11,7 @@ type ExpressionTransformer interface {
 	RewriteBinaryExpression(*BinaryExpression) Expression
 	RewriteUnaryExpression(*UnaryExpression) Expression
 	RewritePostfixExpression(*PostfixExpression) Expression
+	RewritePropagationExpression(*PropagationExpression) Expression
 	RewriteCreateExpression(*CreateExpression) Expression
 	RewriteCallArgument(*CallArgument) Expression
 	RewriteCallExpression(*CallExpression) Expression
@@ -25,11 +27,13 @@ type DelegatingExpressionTransformer struct {
 	BinaryExpressionVisitor      func(node *BinaryExpression) Expression
 	UnaryExpressionVisitor       func(node *UnaryExpression) Expression
 	PostfixExpressionVisitor     func(node *PostfixExpression) Expression
+	PropagationExpressionVisitor func(node *PropagationExpression) Expression
 	CreateExpressionVisitor      func(node *CreateExpression) Expression
 	CallArgumentVisitor          func(node *CallArgument) Expression
 	CallExpressionVisitor        func(node *CallExpression) Expression
 	LetBindingVisitor            func(node *LetBinding) Expression
 	ListExpressionVisitor        func(node *ListExpression) Expression
+	MapExpressionVisitor         func(node *MapExpression) Expression
 }
 
 func NewDelegatingExpressionTransformer() *DelegatingExpressionTransformer {
@@ -58,6 +62,9 @@ func NewDelegatingExpressionTransformer() *DelegatingExpressionTransformer {
 		PostfixExpressionVisitor: func(node *PostfixExpression) Expression {
 			return node
 		},
+		PropagationExpressionVisitor: func(node *PropagationExpression) Expression {
+			return node
+		},
 		CreateExpressionVisitor: func(node *CreateExpression) Expression {
 			return node
 		},
@@ -73,6 +80,9 @@ func NewDelegatingExpressionTransformer() *DelegatingExpressionTransformer {
 		ListExpressionVisitor: func(node *ListExpression) Expression {
 			return node
 		},
+		MapExpressionVisitor: func(node *MapExpression) Expression {
+			return node
+		},
 	}
 }
 
@@ -100,6 +110,9 @@ func (visitor *DelegatingExpressionTransformer) RewriteUnaryExpression(node *Una
 func (visitor *DelegatingExpressionTransformer) RewritePostfixExpression(node *PostfixExpression) Expression {
 	return visitor.PostfixExpressionVisitor(node)
 }
+func (visitor *DelegatingExpressionTransformer) RewritePropagationExpression(node *PropagationExpression) Expression {
+	return visitor.PropagationExpressionVisitor(node)
+}
 func (visitor *DelegatingExpressionTransformer) RewriteCreateExpression(node *CreateExpression) Expression {
 	return visitor.CreateExpressionVisitor(node)
 }
@@ -115,3 +128,6 @@ func (visitor *DelegatingExpressionTransformer) RewriteLetBinding(node *LetBindi
 func (visitor *DelegatingExpressionTransformer) RewriteListExpression(node *ListExpression) Expression {
 	return visitor.ListExpressionVisitor(node)
 }
+func (visitor *DelegatingExpressionTransformer) RewriteMapExpression(node *MapExpression) Expression {
+	return visitor.MapExpressionVisitor(node)
+}
@@ -1,6 +1,11 @@
 package tree
 
-import "github.com/strict-lang/sdk/pkg/compiler/input"
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+)
 
 type FieldDeclaration struct {
 	Name     *Identifier
@@ -10,6 +15,15 @@ type FieldDeclaration struct {
 	Inferred bool
 }
 
+// IsExported returns true if the field is a member of its class, that is
+// visible to other units. Like the methods of the builtin classes, exported
+// members start with an upper case letter. Other fields of the class are
+// private to it and local variables are never exported.
+func (field *FieldDeclaration) IsExported() bool {
+	first, _ := utf8.DecodeRuneInString(field.Name.Value)
+	return unicode.IsUpper(first) && !IsInsideOfMethod(field)
+}
+
 func (field *FieldDeclaration) SetEnclosingNode(target Node) {
 	field.Parent = target
 }
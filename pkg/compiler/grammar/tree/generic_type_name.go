@@ -22,6 +22,10 @@ type Generic struct {
 	Name       string
 	IsWildcard bool
 	Expression Expression
+	// TypeName is the name of the type, that is passed as the argument. It is
+	// only set for generics that are created from resolved types, since
+	// parsed arguments are identifiers.
+	TypeName TypeName
 }
 
 const WildcardName = "_wildcard"
@@ -42,6 +46,19 @@ func NewLetBindingGeneric(binding *LetBinding) *Generic {
 	}
 }
 
+// NewTypeNameGeneric creates a generic, that passes the named type. The type
+// is also referred to by an identifier that holds its full name, so that the
+// generic can be visited and matched like a parsed one.
+func NewTypeNameGeneric(name TypeName) *Generic {
+	identifier := &Identifier{Value: name.FullName(), Region: name.Locate()}
+	return &Generic{
+		Name:       identifier.Value,
+		IsWildcard: false,
+		Expression: identifier,
+		TypeName:   name,
+	}
+}
+
 func NewIdentifierGeneric(identifier *Identifier) *Generic {
 	return &Generic{
 		Name:       identifier.Value,
@@ -68,6 +68,16 @@ func (statement *ImportStatement) ModuleName() string {
 	return statement.Target.Namespace()
 }
 
+// ImportedNamespace returns the qualified name of the imported namespace.
+// Identifier chains are imported into an anonymous namespace, but still name
+// the namespace, that they import.
+func (statement *ImportStatement) ImportedNamespace() string {
+	if chain, ok := statement.Target.(*IdentifierChainImport); ok {
+		return strings.Join(chain.Chain, ".")
+	}
+	return statement.Target.Namespace()
+}
+
 type IdentifierChainImport struct {
 	Chain []string
 }
@@ -0,0 +1,89 @@
+package tree
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+// MapExpression is a literal of a map. It lists the entries of the map,
+// enclosed in curly braces: '{"one": 1, "two": 2}'.
+type MapExpression struct {
+	Entries      []*MapEntry
+	Region       input.Region
+	Parent       Node
+	resolvedType resolvedType
+}
+
+// MapEntry associates a key with a value in a map literal. Entries are not
+// nodes themselves, their key and value are children of the MapExpression.
+type MapEntry struct {
+	Key   Expression
+	Value Expression
+}
+
+func (expression *MapExpression) SetEnclosingNode(target Node) {
+	expression.Parent = target
+}
+
+func (expression *MapExpression) EnclosingNode() (Node, bool) {
+	return expression.Parent, expression.Parent != nil
+}
+
+func (expression *MapExpression) ResolveType(class *scope.Class) {
+	expression.resolvedType.resolve(class)
+}
+
+func (expression *MapExpression) ResolvedType() (*scope.Class, bool) {
+	return expression.resolvedType.class()
+}
+
+func (expression *MapExpression) Accept(visitor Visitor) {
+	visitor.VisitMapExpression(expression)
+}
+
+func (expression *MapExpression) AcceptRecursive(visitor Visitor) {
+	expression.Accept(visitor)
+	for _, entry := range expression.Entries {
+		entry.Key.AcceptRecursive(visitor)
+		entry.Value.AcceptRecursive(visitor)
+	}
+}
+
+func (expression *MapExpression) Locate() input.Region {
+	return expression.Region
+}
+
+func (expression *MapExpression) Matches(node Node) bool {
+	if target, ok := node.(*MapExpression); ok {
+		return expression.entriesMatch(target.Entries)
+	}
+	return false
+}
+
+func (expression *MapExpression) entriesMatch(target []*MapEntry) bool {
+	if len(target) != len(expression.Entries) {
+		return false
+	}
+	for index, entry := range target {
+		own := expression.Entries[index]
+		if !own.Key.Matches(entry.Key) || !own.Value.Matches(entry.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (expression *MapExpression) TransformExpressions(
+	transformer ExpressionTransformer) {
+
+	for _, entry := range expression.Entries {
+		entry.Key = entry.Key.Transform(transformer)
+		entry.Value = entry.Value.Transform(transformer)
+	}
+}
+
+func (expression *MapExpression) Transform(
+	transformer ExpressionTransformer) Expression {
+
+	return transformer.RewriteMapExpression(expression)
+}
@@ -0,0 +1,38 @@
+package tree
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+	"testing"
+)
+
+func createTestMapExpression(region input.Region) *MapExpression {
+	return &MapExpression{
+		Entries: []*MapEntry{
+			{
+				Key:   &WildcardNode{Region: input.ZeroRegion},
+				Value: &WildcardNode{Region: input.ZeroRegion},
+			},
+		},
+		Region: region,
+	}
+}
+
+func TestMapExpression_Accept(testing *testing.T) {
+	entry := createTestMapExpression(input.ZeroRegion)
+	CreateVisitorTest(entry, testing).Expect(MapExpressionNodeKind).Run()
+}
+
+func TestMapExpression_AcceptRecursive(testing *testing.T) {
+	entry := createTestMapExpression(input.ZeroRegion)
+	CreateVisitorTest(entry, testing).
+		Expect(MapExpressionNodeKind).
+		Expect(WildcardNodeKind).
+		Expect(WildcardNodeKind).
+		RunRecursive()
+}
+
+func TestMapExpression_Locate(testing *testing.T) {
+	RunNodeRegionTest(testing, func(region input.Region) Node {
+		return createTestMapExpression(region)
+	})
+}
@@ -0,0 +1,123 @@
+package tree
+
+import "github.com/strict-lang/sdk/pkg/compiler/input"
+
+// MatchStatement inspects a value of a union and executes the arm of its
+// case. The payload of the case is bound to the names that follow the name
+// of the case. Arms have to cover every case of the union, unless the match
+// has an else arm.
+//
+//   match shape
+//     Circle(radius)
+//       return radius * radius
+//     else
+//       return 0
+//
+type MatchStatement struct {
+	Subject Expression
+	Arms    []*MatchArm
+	Region  input.Region
+	Parent  Node
+}
+
+// MatchArm is a single arm of a match. The case of the default arm, which is
+// introduced by the else keyword, is nil.
+type MatchArm struct {
+	Case     *Identifier
+	Bindings []*Identifier
+	Body     *StatementBlock
+	Region   input.Region
+}
+
+func (arm *MatchArm) IsDefault() bool {
+	return arm.Case == nil
+}
+
+func (match *MatchStatement) SetEnclosingNode(target Node) {
+	match.Parent = target
+}
+
+func (match *MatchStatement) EnclosingNode() (Node, bool) {
+	return match.Parent, match.Parent != nil
+}
+
+// HasDefaultArm returns true if the match has an else arm.
+func (match *MatchStatement) HasDefaultArm() bool {
+	for _, arm := range match.Arms {
+		if arm.IsDefault() {
+			return true
+		}
+	}
+	return false
+}
+
+func (match *MatchStatement) Accept(visitor Visitor) {
+	visitor.VisitMatchStatement(match)
+}
+
+func (match *MatchStatement) AcceptRecursive(visitor Visitor) {
+	match.Accept(visitor)
+	match.Subject.AcceptRecursive(visitor)
+	for _, arm := range match.Arms {
+		if !arm.IsDefault() {
+			arm.Case.AcceptRecursive(visitor)
+		}
+		for _, binding := range arm.Bindings {
+			binding.AcceptRecursive(visitor)
+		}
+		arm.Body.AcceptRecursive(visitor)
+	}
+}
+
+func (match *MatchStatement) Locate() input.Region {
+	return match.Region
+}
+
+func (match *MatchStatement) IsModifyingControlFlow() bool {
+	return true
+}
+
+func (match *MatchStatement) Matches(node Node) bool {
+	if target, ok := node.(*MatchStatement); ok {
+		return match.Subject.Matches(target.Subject) &&
+			match.matchesArms(target.Arms)
+	}
+	return false
+}
+
+func (match *MatchStatement) matchesArms(target []*MatchArm) bool {
+	if len(match.Arms) != len(target) {
+		return false
+	}
+	for index, arm := range match.Arms {
+		if !arm.Matches(target[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (arm *MatchArm) Matches(target *MatchArm) bool {
+	if arm.IsDefault() || target.IsDefault() {
+		return arm.IsDefault() == target.IsDefault() && arm.Body.Matches(target.Body)
+	}
+	return arm.Case.Matches(target.Case) &&
+		arm.matchesBindings(target.Bindings) &&
+		arm.Body.Matches(target.Body)
+}
+
+func (arm *MatchArm) matchesBindings(target []*Identifier) bool {
+	if len(arm.Bindings) != len(target) {
+		return false
+	}
+	for index, binding := range arm.Bindings {
+		if !binding.Matches(target[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (match *MatchStatement) TransformExpressions(transformer ExpressionTransformer) {
+	match.Subject = match.Subject.Transform(transformer)
+}
@@ -0,0 +1,61 @@
+package tree
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+	"testing"
+)
+
+var _ Statement = &MatchStatement{}
+
+func createTestMatchStatement(region input.Region) *MatchStatement {
+	return &MatchStatement{
+		Subject: &WildcardNode{Region: input.ZeroRegion},
+		Arms: []*MatchArm{
+			{
+				Case: &Identifier{Value: "Circle", Region: input.ZeroRegion},
+				Bindings: []*Identifier{
+					{Value: "radius", Region: input.ZeroRegion},
+				},
+				Body: &StatementBlock{Region: input.ZeroRegion},
+			},
+			{
+				Body: &StatementBlock{Region: input.ZeroRegion},
+			},
+		},
+		Region: region,
+	}
+}
+
+func TestMatchStatement_Accept(testing *testing.T) {
+	entry := createTestMatchStatement(input.ZeroRegion)
+	CreateVisitorTest(entry, testing).Expect(MatchStatementNodeKind).Run()
+}
+
+func TestMatchStatement_AcceptRecursive(testing *testing.T) {
+	entry := createTestMatchStatement(input.ZeroRegion)
+	CreateVisitorTest(entry, testing).
+		Expect(MatchStatementNodeKind).
+		Expect(WildcardNodeKind).
+		Expect(IdentifierNodeKind).
+		Expect(IdentifierNodeKind).
+		Expect(StatementBlockNodeKind).
+		Expect(StatementBlockNodeKind).
+		RunRecursive()
+}
+
+func TestMatchStatement_Locate(testing *testing.T) {
+	RunNodeRegionTest(testing, func(region input.Region) Node {
+		return createTestMatchStatement(region)
+	})
+}
+
+func TestMatchStatement_HasDefaultArm(testing *testing.T) {
+	entry := createTestMatchStatement(input.ZeroRegion)
+	if !entry.HasDefaultArm() {
+		testing.Error("Expected MatchStatement to have a default arm")
+	}
+	entry.Arms = entry.Arms[:1]
+	if entry.HasDefaultArm() {
+		testing.Error("Expected MatchStatement not to have a default arm")
+	}
+}
@@ -15,7 +15,24 @@ type MethodDeclaration struct {
 	Region     input.Region
 	Parent     Node
 	Abstract   bool
-	scope      scope.Scope
+	// Extended is the name of the class that is extended by the method.
+	// It is nil, if the method is not an extension method.
+	Extended TypeName
+	scope    scope.Scope
+}
+
+// IsExtension returns true if the method extends an existing class. Extension
+// methods are not members of the class they are declared in, but of the
+// extended class, which may also be a builtin like String or Number.
+func (declaration *MethodDeclaration) IsExtension() bool {
+	return declaration.Extended != nil
+}
+
+// ReturnsResult returns true if the method can fail and thus returns its
+// value wrapped into a Result.
+func (declaration *MethodDeclaration) ReturnsResult() bool {
+	return declaration.Type != nil &&
+		declaration.Type.BaseName() == scope.ResultClassName
 }
 
 func (declaration *MethodDeclaration) UpdateScope(target scope.Scope) {
@@ -40,6 +57,9 @@ func (declaration *MethodDeclaration) Accept(visitor Visitor) {
 
 func (declaration *MethodDeclaration) AcceptRecursive(visitor Visitor) {
 	declaration.Accept(visitor)
+	if declaration.Extended != nil {
+		declaration.Extended.AcceptRecursive(visitor)
+	}
 	if declaration.Type != nil {
 		declaration.Type.AcceptRecursive(visitor)
 	}
@@ -58,11 +78,19 @@ func (declaration *MethodDeclaration) Matches(node Node) bool {
 		return declaration.Name.Matches(target.Name) &&
 			declaration.Type.Matches(target.Type) &&
 			declaration.Parameters.Matches(target.Parameters) &&
-			declaration.Body.Matches(target.Body)
+			declaration.Body.Matches(target.Body) &&
+			declaration.extendedTypeMatches(target.Extended)
 	}
 	return false
 }
 
+func (declaration *MethodDeclaration) extendedTypeMatches(target TypeName) bool {
+	if declaration.Extended == nil || target == nil {
+		return declaration.Extended == nil && target == nil
+	}
+	return declaration.Extended.Matches(target)
+}
+
 func (list ParameterList) Matches(target ParameterList) bool {
 	if len(list) != len(target) {
 		return false
@@ -74,3 +102,34 @@ func (list ParameterList) Matches(target ParameterList) bool {
 	}
 	return true
 }
+
+// Preconditions returns the `requires` clauses of the method. They are
+// declared at the begin of the methods body.
+func (declaration *MethodDeclaration) Preconditions() []*AssertStatement {
+	return declaration.filterContractClauses(Precondition)
+}
+
+// Postconditions returns the `ensures` clauses of the method. They are
+// declared at the begin of the methods body.
+func (declaration *MethodDeclaration) Postconditions() []*AssertStatement {
+	return declaration.filterContractClauses(Postcondition)
+}
+
+func (declaration *MethodDeclaration) filterContractClauses(
+	kind AssertionKind) (clauses []*AssertStatement) {
+
+	block, isBlock := declaration.Body.(*StatementBlock)
+	if !isBlock {
+		return nil
+	}
+	for _, child := range block.Children {
+		assert, isAssert := child.(*AssertStatement)
+		if !isAssert || !assert.IsContractClause() {
+			break
+		}
+		if assert.Kind == kind {
+			clauses = append(clauses, assert)
+		}
+	}
+	return clauses
+}
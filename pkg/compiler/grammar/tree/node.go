@@ -38,6 +38,8 @@ const (
 	BinaryExpressionNodeKind
 	UnaryExpressionNodeKind
 	PostfixExpressionNodeKind
+	PropagationExpressionNodeKind
+	MapExpressionNodeKind
 	CreateExpressionNodeKind
 	CallArgumentNodeKind
 	CallExpressionNodeKind
@@ -58,6 +60,7 @@ const (
 	ExpressionStatementNodeKind
 	ForEachLoopStatementNodeKind
 	RangedLoopStatementNodeKind
+	MatchStatementNodeKind
 	ImplementStatementNodeKind
 	ListExpressionNodeKind
 	GenericStatementNodeKind
@@ -68,6 +71,7 @@ const (
 	MethodDeclarationNodeKind
 	ClassDeclarationNodeKind
 	ConstructorDeclarationNodeKind
+	UnionDeclarationNodeKind
 	declarationKindEnd
 	typeNameKindBegin
 	TypeNameNodeGroup // Used only in parsing
@@ -90,6 +94,8 @@ var nodeKindNames = map[NodeKind]string{
 	BinaryExpressionNodeKind:       "BinaryExpression",
 	UnaryExpressionNodeKind:        "UnaryExpression",
 	PostfixExpressionNodeKind:      "PostfixExpression",
+	PropagationExpressionNodeKind:  "PropagationExpression",
+	MapExpressionNodeKind:          "MapExpression",
 	CreateExpressionNodeKind:       "CreateExpression",
 	CallArgumentNodeKind:           "CallArgument",
 	CallExpressionNodeKind:         "CallExpression",
@@ -107,11 +113,13 @@ var nodeKindNames = map[NodeKind]string{
 	ExpressionStatementNodeKind:    "ExpressionStatement",
 	ForEachLoopStatementNodeKind:   "ForEachLoopStatement",
 	RangedLoopStatementNodeKind:    "RangedLoopStatement",
+	MatchStatementNodeKind:         "MatchStatement",
 	ParameterNodeKind:              "Parameter",
 	FieldDeclarationNodeKind:       "FieldDeclaration",
 	MethodDeclarationNodeKind:      "MethodDeclaration",
 	ClassDeclarationNodeKind:       "ClassDeclaration",
 	ConstructorDeclarationNodeKind: "ConstructorDeclaration",
+	UnionDeclarationNodeKind:       "UnionDeclaration",
 	TypeNameNodeGroup:              "TypeName",
 	ListTypeNameNodeKind:           "ListTypeName",
 	GenericTypeNameNodeKind:        "GenericTypeName",
@@ -47,6 +47,7 @@ func newPrinting() *Printing {
 		MethodDeclarationVisitor:      printing.printMethodDeclaration,
 		FieldSelectExpressionVisitor:  printing.printFieldSelectExpression,
 		PostfixExpressionVisitor:      printing.printPostfixExpression,
+		PropagationExpressionVisitor:  printing.printPropagationExpression,
 		RangedLoopStatementVisitor:    printing.printRangedLoopStatement,
 		ExpressionStatementVisitor:    printing.printExpressionStatement,
 		ForEachLoopStatementVisitor:   printing.printForEachLoopStatement,
@@ -56,6 +57,9 @@ func newPrinting() *Printing {
 		ConstructorDeclarationVisitor: printing.printConstructorDeclaration,
 		WildcardNodeVisitor:           printing.printWildcardNode,
 		ListExpressionVisitor:         printing.printListExpression,
+		MapExpressionVisitor:          printing.printMapExpression,
+		UnionDeclarationVisitor:       printing.printUnionDeclaration,
+		MatchStatementVisitor:         printing.printMatchStatement,
 	}
 	printing.visitor = visitor
 	return printing
@@ -315,6 +319,9 @@ func (printing *Printing) printNumberLiteral(number *tree.NumberLiteral) {
 
 func (printing *Printing) printAssertStatement(statement *tree.AssertStatement) {
 	printing.printNodeBegin("AssertStatement")
+	if statement.IsContractClause() {
+		printing.printIndentedStringField("kind", statement.Kind.String())
+	}
 	printing.printIndentedNodeField("expression", statement.Expression)
 	printing.printNodeEnd()
 }
@@ -345,6 +352,17 @@ func (printing *Printing) printListExpression(list *tree.ListExpression) {
 	printing.printNodeEnd()
 }
 
+func (printing *Printing) printMapExpression(expression *tree.MapExpression) {
+	printing.printNodeBegin("Map")
+	printing.printIndentedListFieldBegin("entries")
+	for _, entry := range expression.Entries {
+		printing.printListField(entry.Key)
+		printing.printListField(entry.Value)
+	}
+	printing.printListFieldEnd()
+	printing.printNodeEnd()
+}
+
 func (printing *Printing) printConditionalStatement(statement *tree.ConditionalStatement) {
 	printing.printNodeBegin("ConditionalStatement")
 	printing.printIndentedNodeField("condition", statement.Condition)
@@ -375,6 +393,9 @@ func (printing *Printing) printForEachLoopStatement(statement *tree.ForEachLoopS
 func (printing *Printing) printMethodDeclaration(method *tree.MethodDeclaration) {
 	printing.printNodeBegin("MethodDeclaration")
 	printing.printIndentedNodeField("name", method.Name)
+	if method.IsExtension() {
+		printing.printIndentedNodeField("extended", method.Extended)
+	}
 	printing.printIndentedNodeField("returnType", method.Type)
 	printing.printParameterList(method.Parameters)
 	if method.Body != nil {
@@ -391,6 +412,40 @@ func (printing *Printing) printParameterList(parameters tree.ParameterList) {
 	printing.printListFieldEnd()
 }
 
+func (printing *Printing) printUnionDeclaration(declaration *tree.UnionDeclaration) {
+	printing.printNodeBegin("UnionDeclaration")
+	printing.printIndentedNodeField("name", declaration.Name)
+	for _, unionCase := range declaration.Cases {
+		printing.printIndentedListFieldBegin(unionCase.Name.Value)
+		for _, parameter := range unionCase.Payload {
+			printing.printListField(parameter)
+		}
+		printing.printListFieldEnd()
+	}
+	printing.printNodeEnd()
+}
+
+func (printing *Printing) printMatchStatement(statement *tree.MatchStatement) {
+	printing.printNodeBegin("MatchStatement")
+	printing.printIndentedNodeField("subject", statement.Subject)
+	for _, arm := range statement.Arms {
+		printing.printIndentedListFieldBegin(nameOfMatchArm(arm))
+		for _, binding := range arm.Bindings {
+			printing.printListField(binding)
+		}
+		printing.printListField(arm.Body)
+		printing.printListFieldEnd()
+	}
+	printing.printNodeEnd()
+}
+
+func nameOfMatchArm(arm *tree.MatchArm) string {
+	if arm.IsDefault() {
+		return "else"
+	}
+	return arm.Case.Value
+}
+
 func (printing *Printing) printWildcardNode(node *tree.WildcardNode) {
 	printing.printFieldName("*")
 }
@@ -439,6 +494,13 @@ func (printing *Printing) printPostfixExpression(statement *tree.PostfixExpressi
 	printing.printNodeEnd()
 }
 
+func (printing *Printing) printPropagationExpression(expression *tree.PropagationExpression) {
+	printing.printNodeBegin("PropagationExpression")
+	printing.printIndentedNodeField("operand", expression.Operand)
+	printing.printResolvedType(expression)
+	printing.printNodeEnd()
+}
+
 func (printing *Printing) printFieldSelectExpression(expression *tree.ChainExpression) {
 	printing.printNodeBegin("Chain")
 	printing.printIndentedListFieldBegin("Expressions")
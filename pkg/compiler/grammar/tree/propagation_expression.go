@@ -0,0 +1,66 @@
+package tree
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+// PropagationExpression unwraps the value of a Result. If the Result is a
+// failure, the enclosing method is left and the failure is returned to its
+// caller. It is written as the operand followed by a question mark:
+// 'let number = parseNumber(text)?'.
+type PropagationExpression struct {
+	// Operand is the expression that evaluates to a Result.
+	Operand      Expression
+	Region       input.Region
+	Parent       Node
+	resolvedType resolvedType
+}
+
+func (propagation *PropagationExpression) ResolveType(class *scope.Class) {
+	propagation.resolvedType.resolve(class)
+}
+
+func (propagation *PropagationExpression) ResolvedType() (*scope.Class, bool) {
+	return propagation.resolvedType.class()
+}
+
+func (propagation *PropagationExpression) SetEnclosingNode(target Node) {
+	propagation.Parent = target
+}
+
+func (propagation *PropagationExpression) EnclosingNode() (Node, bool) {
+	return propagation.Parent, propagation.Parent != nil
+}
+
+func (propagation *PropagationExpression) Accept(visitor Visitor) {
+	visitor.VisitPropagationExpression(propagation)
+}
+
+func (propagation *PropagationExpression) AcceptRecursive(visitor Visitor) {
+	propagation.Accept(visitor)
+	propagation.Operand.AcceptRecursive(visitor)
+}
+
+func (propagation *PropagationExpression) Locate() input.Region {
+	return propagation.Region
+}
+
+func (propagation *PropagationExpression) Matches(node Node) bool {
+	if target, ok := node.(*PropagationExpression); ok {
+		return propagation.Operand.Matches(target.Operand)
+	}
+	return false
+}
+
+func (propagation *PropagationExpression) TransformExpressions(
+	transformer ExpressionTransformer) {
+
+	propagation.Operand = propagation.Operand.Transform(transformer)
+}
+
+func (propagation *PropagationExpression) Transform(
+	transformer ExpressionTransformer) Expression {
+
+	return transformer.RewritePropagationExpression(propagation)
+}
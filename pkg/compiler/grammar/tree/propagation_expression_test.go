@@ -0,0 +1,34 @@
+package tree
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+	"testing"
+)
+
+func TestPropagationExpression_Accept(testing *testing.T) {
+	entry := &PropagationExpression{
+		Operand: &WildcardNode{Region: input.ZeroRegion},
+		Region:  input.ZeroRegion,
+	}
+	CreateVisitorTest(entry, testing).Expect(PropagationExpressionNodeKind).Run()
+}
+
+func TestPropagationExpression_AcceptRecursive(testing *testing.T) {
+	entry := &PropagationExpression{
+		Operand: &WildcardNode{Region: input.ZeroRegion},
+		Region:  input.ZeroRegion,
+	}
+	CreateVisitorTest(entry, testing).
+		Expect(PropagationExpressionNodeKind).
+		Expect(WildcardNodeKind).
+		RunRecursive()
+}
+
+func TestPropagationExpression_Locate(testing *testing.T) {
+	RunNodeRegionTest(testing, func(region input.Region) Node {
+		return &PropagationExpression{
+			Operand: &WildcardNode{Region: input.ZeroRegion},
+			Region:  region,
+		}
+	})
+}
@@ -2,6 +2,7 @@ package tree
 
 import (
 	"github.com/strict-lang/sdk/pkg/compiler/input"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
 	"github.com/strict-lang/sdk/pkg/compiler/typing"
 )
 
@@ -52,6 +53,26 @@ func (parser *typeNameParser) VisitConcrete(concrete *typing.ConcreteType) {
 	}
 }
 
+// VisitUnion parses the name of the union. Unions are referred to by their
+// name, like concrete classes.
+func (parser *typeNameParser) VisitUnion(union *typing.UnionType) {
+	parser.lastType = &ConcreteTypeName{
+		Region:        parser.region,
+		Name:          union.Name,
+		typeReference: &TypeReference{resolved: union},
+	}
+}
+
+// VisitParameter parses the name of a type parameter. Parameters are referred
+// to by their name, like concrete classes.
+func (parser *typeNameParser) VisitParameter(parameter *typing.ParameterType) {
+	parser.lastType = &ConcreteTypeName{
+		Region:        parser.region,
+		Name:          parameter.Name,
+		typeReference: &TypeReference{resolved: parameter},
+	}
+}
+
 func (parser *typeNameParser) VisitGeneric(generic *typing.GenericType) {
 	generics := make([]TypeName, len(generic.Arguments))
 	for index, argument := range generic.Arguments {
@@ -59,14 +80,20 @@ func (parser *typeNameParser) VisitGeneric(generic *typing.GenericType) {
 	}
 	parser.lastType = &GenericTypeName{
 		Name:          generic.Concrete().String(),
-		Arguments:     translateTypeNamesToGeneric(generic.Arguments),
+		Arguments:     parser.translateTypeNamesToGeneric(generics),
 		Region:        parser.region,
 		typeReference: &TypeReference{resolved: generic},
 	}
 }
 
-func translateTypeNamesToGeneric(types []typing.Type) (generics []*Generic) {
-	// TODO: Add proper translation
+// translateTypeNamesToGeneric translates the type names into generics, that
+// keep the parsed type names of nested lists and generics.
+func (parser *typeNameParser) translateTypeNamesToGeneric(
+	names []TypeName) (generics []*Generic) {
+
+	for _, name := range names {
+		generics = append(generics, NewTypeNameGeneric(name))
+	}
 	return generics
 }
 
@@ -78,6 +105,16 @@ func (parser *typeNameParser) VisitList(list *typing.ListType) {
 	}
 }
 
+func (parser *typeNameParser) VisitMap(mapType *typing.MapType) {
+	arguments := []TypeName{parser.parse(mapType.Key), parser.parse(mapType.Value)}
+	parser.lastType = &GenericTypeName{
+		Name:          scope.MapClassName,
+		Arguments:     parser.translateTypeNamesToGeneric(arguments),
+		Region:        parser.region,
+		typeReference: &TypeReference{resolved: mapType},
+	}
+}
+
 type TypeReference struct {
 	resolved typing.Type
 }
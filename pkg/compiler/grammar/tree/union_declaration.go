@@ -0,0 +1,89 @@
+package tree
+
+import "github.com/strict-lang/sdk/pkg/compiler/input"
+
+// UnionDeclaration declares a union, which is a closed set of cases. Every
+// value of the union is exactly one of its cases and carries the payload of
+// that case. Unions are declared by listing their cases:
+//
+//   type Shape
+//     Circle(radius Number)
+//     Rectangle(width Number, height Number)
+//     Empty
+//
+type UnionDeclaration struct {
+	Name   *Identifier
+	Cases  []*UnionCase
+	Region input.Region
+	Parent Node
+}
+
+// UnionCase is a single case of a union. Its payload is declared like the
+// parameters of a method and is passed when creating a value of the case.
+type UnionCase struct {
+	Name    *Identifier
+	Payload ParameterList
+	Region  input.Region
+}
+
+func (declaration *UnionDeclaration) SetEnclosingNode(target Node) {
+	declaration.Parent = target
+}
+
+func (declaration *UnionDeclaration) EnclosingNode() (Node, bool) {
+	return declaration.Parent, declaration.Parent != nil
+}
+
+// FindCase returns the case with the passed name.
+func (declaration *UnionDeclaration) FindCase(name string) (*UnionCase, bool) {
+	for _, unionCase := range declaration.Cases {
+		if unionCase.Name.Value == name {
+			return unionCase, true
+		}
+	}
+	return nil, false
+}
+
+func (declaration *UnionDeclaration) Accept(visitor Visitor) {
+	visitor.VisitUnionDeclaration(declaration)
+}
+
+func (declaration *UnionDeclaration) AcceptRecursive(visitor Visitor) {
+	declaration.Accept(visitor)
+	declaration.Name.AcceptRecursive(visitor)
+	for _, unionCase := range declaration.Cases {
+		unionCase.Name.AcceptRecursive(visitor)
+		for _, parameter := range unionCase.Payload {
+			parameter.AcceptRecursive(visitor)
+		}
+	}
+}
+
+func (declaration *UnionDeclaration) Locate() input.Region {
+	return declaration.Region
+}
+
+func (declaration *UnionDeclaration) Matches(node Node) bool {
+	if target, ok := node.(*UnionDeclaration); ok {
+		return declaration.Name.Matches(target.Name) &&
+			declaration.matchesCases(target.Cases)
+	}
+	return false
+}
+
+func (declaration *UnionDeclaration) matchesCases(target []*UnionCase) bool {
+	if len(declaration.Cases) != len(target) {
+		return false
+	}
+	for index, unionCase := range declaration.Cases {
+		if !unionCase.Matches(target[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (unionCase *UnionCase) Matches(target *UnionCase) bool {
+	return unionCase.Name.Matches(target.Name) &&
+		unionCase.Payload.Matches(target.Payload)
+}
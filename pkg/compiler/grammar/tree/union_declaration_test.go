@@ -0,0 +1,64 @@
+package tree
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+	"testing"
+)
+
+var _ Node = &UnionDeclaration{}
+
+func createTestUnionDeclaration(region input.Region) *UnionDeclaration {
+	return &UnionDeclaration{
+		Name: &Identifier{Value: "Shape", Region: input.ZeroRegion},
+		Cases: []*UnionCase{
+			{
+				Name: &Identifier{Value: "Circle", Region: input.ZeroRegion},
+				Payload: ParameterList{
+					{
+						Name:   &Identifier{Value: "radius", Region: input.ZeroRegion},
+						Type:   &ConcreteTypeName{Name: "Number", Region: input.ZeroRegion},
+						Region: input.ZeroRegion,
+					},
+				},
+			},
+			{
+				Name: &Identifier{Value: "Empty", Region: input.ZeroRegion},
+			},
+		},
+		Region: region,
+	}
+}
+
+func TestUnionDeclaration_Accept(testing *testing.T) {
+	entry := createTestUnionDeclaration(input.ZeroRegion)
+	CreateVisitorTest(entry, testing).Expect(UnionDeclarationNodeKind).Run()
+}
+
+func TestUnionDeclaration_AcceptRecursive(testing *testing.T) {
+	entry := createTestUnionDeclaration(input.ZeroRegion)
+	CreateVisitorTest(entry, testing).
+		Expect(UnionDeclarationNodeKind).
+		Expect(IdentifierNodeKind).
+		Expect(IdentifierNodeKind).
+		Expect(ParameterNodeKind).
+		Expect(IdentifierNodeKind).
+		Expect(ConcreteTypeNameNodeKind).
+		Expect(IdentifierNodeKind).
+		RunRecursive()
+}
+
+func TestUnionDeclaration_Locate(testing *testing.T) {
+	RunNodeRegionTest(testing, func(region input.Region) Node {
+		return createTestUnionDeclaration(region)
+	})
+}
+
+func TestUnionDeclaration_FindCase(testing *testing.T) {
+	entry := createTestUnionDeclaration(input.ZeroRegion)
+	if _, ok := entry.FindCase("Circle"); !ok {
+		testing.Error("Expected UnionDeclaration to have the case Circle")
+	}
+	if _, ok := entry.FindCase("Square"); ok {
+		testing.Error("Expected UnionDeclaration not to have the case Square")
+	}
+}
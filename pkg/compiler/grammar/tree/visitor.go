@@ -17,6 +17,9 @@ type Visitor interface {
 	VisitStringLiteral(*StringLiteral)
 	VisitNumberLiteral(*NumberLiteral)
 	VisitListExpression(*ListExpression)
+	VisitMapExpression(*MapExpression)
+	VisitUnionDeclaration(*UnionDeclaration)
+	VisitMatchStatement(*MatchStatement)
 	VisitCallExpression(*CallExpression)
 	VisitEmptyStatement(*EmptyStatement)
 	VisitYieldStatement(*YieldStatement)
@@ -39,6 +42,7 @@ type Visitor interface {
 	VisitBinaryExpression(*BinaryExpression)
 	VisitMethodDeclaration(*MethodDeclaration)
 	VisitPostfixExpression(*PostfixExpression)
+	VisitPropagationExpression(*PropagationExpression)
 	VisitImplementStatement(*ImplementStatement)
 	VisitRangedLoopStatement(*RangedLoopStatement)
 	VisitExpressionStatement(*ExpressionStatement)
@@ -59,6 +63,9 @@ type DelegatingVisitor struct {
 	StringLiteralVisitor          func(*StringLiteral)
 	NumberLiteralVisitor          func(*NumberLiteral)
 	ListExpressionVisitor         func(*ListExpression)
+	MapExpressionVisitor          func(*MapExpression)
+	UnionDeclarationVisitor       func(*UnionDeclaration)
+	MatchStatementVisitor         func(*MatchStatement)
 	CallExpressionVisitor         func(*CallExpression)
 	EmptyStatementVisitor         func(*EmptyStatement)
 	WildcardNodeVisitor           func(*WildcardNode)
@@ -75,6 +82,7 @@ type DelegatingVisitor struct {
 	InvalidStatementVisitor       func(*InvalidStatement)
 	FieldDeclarationVisitor       func(*FieldDeclaration)
 	PostfixExpressionVisitor      func(*PostfixExpression)
+	PropagationExpressionVisitor  func(*PropagationExpression)
 	ImplementStatementVisitor     func(*ImplementStatement)
 	GenericTypeNameVisitor        func(*GenericTypeName)
 	OptionalTypeNameVisitor       func(*OptionalTypeName)
@@ -103,6 +111,9 @@ func NewEmptyVisitor() *DelegatingVisitor {
 		NumberLiteralVisitor:          func(*NumberLiteral) {},
 		CallExpressionVisitor:         func(*CallExpression) {},
 		ListExpressionVisitor:         func(*ListExpression) {},
+		MapExpressionVisitor:          func(*MapExpression) {},
+		UnionDeclarationVisitor:       func(*UnionDeclaration) {},
+		MatchStatementVisitor:         func(*MatchStatement) {},
 		EmptyStatementVisitor:         func(*EmptyStatement) {},
 		YieldStatementVisitor:         func(*YieldStatement) {},
 		WildcardNodeVisitor:           func(*WildcardNode) {},
@@ -118,6 +129,7 @@ func NewEmptyVisitor() *DelegatingVisitor {
 		InvalidStatementVisitor:       func(*InvalidStatement) {},
 		FieldDeclarationVisitor:       func(*FieldDeclaration) {},
 		PostfixExpressionVisitor:      func(*PostfixExpression) {},
+		PropagationExpressionVisitor:  func(*PropagationExpression) {},
 		GenericTypeNameVisitor:        func(*GenericTypeName) {},
 		ConcreteTypeNameVisitor:       func(*ConcreteTypeName) {},
 		ClassDeclarationVisitor:       func(*ClassDeclaration) {},
@@ -270,6 +282,10 @@ func (visitor *DelegatingVisitor) VisitPostfixExpression(node *PostfixExpression
 	visitor.PostfixExpressionVisitor(node)
 }
 
+func (visitor *DelegatingVisitor) VisitPropagationExpression(node *PropagationExpression) {
+	visitor.PropagationExpressionVisitor(node)
+}
+
 func (visitor *DelegatingVisitor) VisitBreakStatement(node *BreakStatement) {
 	visitor.BreakStatementVisitor(node)
 }
@@ -290,6 +306,18 @@ func (visitor *DelegatingVisitor) VisitListExpression(expression *ListExpression
 	visitor.ListExpressionVisitor(expression)
 }
 
+func (visitor *DelegatingVisitor) VisitMapExpression(expression *MapExpression) {
+	visitor.MapExpressionVisitor(expression)
+}
+
+func (visitor *DelegatingVisitor) VisitUnionDeclaration(declaration *UnionDeclaration) {
+	visitor.UnionDeclarationVisitor(declaration)
+}
+
+func (visitor *DelegatingVisitor) VisitMatchStatement(statement *MatchStatement) {
+	visitor.MatchStatementVisitor(statement)
+}
+
 type nodeReporter interface {
 	reportNodeEncounter(kind NodeKind)
 }
@@ -398,6 +426,9 @@ func NewReportingVisitor(reporter nodeReporter) Visitor {
 		PostfixExpressionVisitor: func(*PostfixExpression) {
 			reporter.reportNodeEncounter(PostfixExpressionNodeKind)
 		},
+		PropagationExpressionVisitor: func(*PropagationExpression) {
+			reporter.reportNodeEncounter(PropagationExpressionNodeKind)
+		},
 		WildcardNodeVisitor: func(*WildcardNode) {
 			reporter.reportNodeEncounter(WildcardNodeKind)
 		},
@@ -413,6 +444,15 @@ func NewReportingVisitor(reporter nodeReporter) Visitor {
 		ListExpressionVisitor: func(*ListExpression) {
 			reporter.reportNodeEncounter(ListExpressionNodeKind)
 		},
+		MapExpressionVisitor: func(*MapExpression) {
+			reporter.reportNodeEncounter(MapExpressionNodeKind)
+		},
+		UnionDeclarationVisitor: func(*UnionDeclaration) {
+			reporter.reportNodeEncounter(UnionDeclarationNodeKind)
+		},
+		MatchStatementVisitor: func(*MatchStatement) {
+			reporter.reportNodeEncounter(MatchStatementNodeKind)
+		},
 	}
 }
 
@@ -538,6 +578,9 @@ func (visitor *SingleFunctionVisitor) VisitMethodDeclaration(node *MethodDeclara
 func (visitor *SingleFunctionVisitor) VisitPostfixExpression(node *PostfixExpression) {
 	visitor.visit(node)
 }
+func (visitor *SingleFunctionVisitor) VisitPropagationExpression(node *PropagationExpression) {
+	visitor.visit(node)
+}
 func (visitor *SingleFunctionVisitor) VisitImplementStatement(node *ImplementStatement) {
 	visitor.visit(node)
 }
@@ -566,3 +609,15 @@ func (visitor *SingleFunctionVisitor) VisitConstructorDeclaration(node *Construc
 func (visitor *SingleFunctionVisitor) VisitListExpression(node *ListExpression) {
 	visitor.visit(node)
 }
+
+func (visitor *SingleFunctionVisitor) VisitMapExpression(node *MapExpression) {
+	visitor.visit(node)
+}
+
+func (visitor *SingleFunctionVisitor) VisitUnionDeclaration(node *UnionDeclaration) {
+	visitor.visit(node)
+}
+
+func (visitor *SingleFunctionVisitor) VisitMatchStatement(node *MatchStatement) {
+	visitor.visit(node)
+}
@@ -4,8 +4,10 @@ import "github.com/strict-lang/sdk/pkg/compiler/input"
 
 // YieldStatement yields an expression to an implicit list that is returned by
 // the method it is defined in. Yield statements can only be in methods,
-// returning a 'Sequence'. And their values type have to be of the sequences
-// element type. Those statements are not accompanied by a ReturnStatement.
+// returning a list. And their values type have to be of the lists element
+// type. Those statements are not accompanied by a ReturnStatement. Methods
+// that yield are lowered either into methods that collect their values or
+// into lazy sequences, depending on the backend.
 type YieldStatement struct {
 	Region input.Region
 	Value  Expression
@@ -26,8 +26,9 @@ func (builder *Builder) Append(text string, offset, length input.Offset) {
 
 func (builder *Builder) NewLineMap() *LineMap {
 	return &LineMap{
-		lines:       builder.lines,
-		lineOffsets: builder.offsets,
+		lines:        builder.lines,
+		lineOffsets:  builder.offsets,
+		recentOffset: noRecentOffset,
 	}
 }
 
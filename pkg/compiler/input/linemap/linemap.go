@@ -11,6 +11,10 @@ type LineMap struct {
 	recentLine   input.LineIndex
 }
 
+// noRecentOffset is the recent offset of line maps, that have not yet looked
+// up any line. It is negative and thus never looked up.
+const noRecentOffset = input.Offset(-1)
+
 type lineEntry struct {
 	length  input.Offset
 	index   input.LineIndex
@@ -73,7 +77,7 @@ func (lines *LineMap) LineAtIndex(lineIndex input.LineIndex) input.Line {
 	entry := lines.lines[lineIndex]
 	return input.Line{
 		Offset: entry.offset,
-		Index:  entry.index + 1,
+		Index:  entry.index,
 		Length: entry.length,
 		Text:   entry.content,
 	}
@@ -87,7 +91,7 @@ func Empty() *LineMap {
 	return &LineMap{
 		lines:        []lineEntry{},
 		lineOffsets:  []input.Offset{},
-		recentOffset: 0,
+		recentOffset: noRecentOffset,
 		recentLine:   0,
 	}
 }
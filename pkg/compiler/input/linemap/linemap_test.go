@@ -1,7 +1,64 @@
 package linemap
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+)
 
 func TestLineAtInvalidOffset(t *testing.T) {
 	// linemap := NewLineMap()
 }
+
+// createTestLineMap creates the line map of the text "first\nsecond\nthird".
+func createTestLineMap() *LineMap {
+	builder := NewBuilder()
+	builder.Append("first", 0, 5)
+	builder.Append("second", 6, 6)
+	builder.Append("third", 13, 5)
+	return builder.NewLineMap()
+}
+
+func TestLineMap_LineAtOffset(testing *testing.T) {
+	entries := []struct {
+		name     string
+		offset   input.Offset
+		expected input.LineIndex
+	}{
+		{"begin of the first line", 0, 1},
+		{"end of the first line", 5, 1},
+		{"right after a newline", 6, 2},
+		{"end of a middle line", 12, 2},
+		{"begin of the last line", 13, 3},
+		{"end of the last line", 17, 3},
+	}
+	lines := createTestLineMap()
+	for _, entry := range entries {
+		if line := lines.LineAtOffset(entry.offset); line != entry.expected {
+			testing.Errorf("%s: offset %d is in line %d, expected %d",
+				entry.name, entry.offset, line, entry.expected)
+		}
+	}
+}
+
+func TestLineMap_PositionAtOffset(testing *testing.T) {
+	lines := createTestLineMap()
+	position := lines.PositionAtOffset(15)
+	if position.Line.Index != 3 || position.Line.Text != "third" || position.Column != 2 {
+		testing.Errorf("unexpected position %+v", position)
+	}
+	first := lines.PositionAtOffset(0)
+	if first.Line.Index != 1 || first.Line.Text != "first" || first.Column != 0 {
+		testing.Errorf("unexpected position of the first offset %+v", first)
+	}
+}
+
+func TestLineMap_LooksUpFirstOffsetOfNewMap(testing *testing.T) {
+	lines := createTestLineMap()
+	if line := lines.LineAtOffset(0); line != 1 {
+		testing.Errorf("offset 0 is in line %d, expected 1", line)
+	}
+	if line := Empty().LineAtOffset(0); line != 0 {
+		testing.Errorf("offset 0 of an empty map is in line %d, expected 0", line)
+	}
+}
@@ -61,6 +61,7 @@ func (reader *streamReader) IsExhausted() bool {
 func NewStreamReader(reader io.Reader) Reader {
 	stream := bufio.NewReader(reader)
 	return &streamReader{
+		index:   -1, // 0 after first pull
 		stream:  stream,
 		current: EndOfFile,
 		peeked:  EndOfFile,
@@ -1 +1,20 @@
 package input
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStreamReader_IndexesFromZero(test *testing.T) {
+	reader := NewStreamReader(strings.NewReader("abc"))
+	for expectedIndex, expectedChar := range "abc" {
+		char := reader.Pull()
+		if char != Char(expectedChar) {
+			test.Errorf("reader pulled %c, expected %c", char, expectedChar)
+		}
+		if reader.Index() != Offset(expectedIndex) {
+			test.Errorf("reader is at index %d after pulling %c, expected %d",
+				reader.Index(), char, expectedIndex)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package lowering
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	"github.com/strict-lang/sdk/pkg/compiler/pass"
+)
+
+// Run runs every lowering pass on the unit of the context.
+func Run(context *pass.Context) error {
+	return pass.RunWithId(CompletionPassId, context)
+}
+
+const CompletionPassId = "LoweringCompletionPass"
+
+func init() {
+	pass.Register(&CompletionPass{})
+}
+
+// CompletionPass does not lower the unit itself. It depends on every pass
+// of the lowering, so that running it runs the whole lowering. New passes
+// have to be added to its dependencies.
+type CompletionPass struct{}
+
+func (completion *CompletionPass) Run(context *pass.Context) {}
+
+func (completion *CompletionPass) Dependencies(isolate *isolate.Isolate) pass.Set {
+	return pass.ListInIsolate(isolate,
+		LetBindingLoweringPassId,
+		YieldLoweringPassId)
+}
+
+func (completion *CompletionPass) Id() pass.Id {
+	return CompletionPassId
+}
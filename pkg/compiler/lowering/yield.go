@@ -0,0 +1,273 @@
+package lowering
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/analysis/semantic"
+	"github.com/strict-lang/sdk/pkg/compiler/backend"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/token"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+const YieldLoweringPassId = "YieldLowering"
+
+// YieldStrategy decides how methods, that yield their values, are lowered.
+type YieldStrategy int
+
+const (
+	// CollectYields lowers yielding methods into methods, that append every
+	// yielded value to a list and return the list once they complete.
+	CollectYields YieldStrategy = iota
+	// GenerateYields lowers yielding methods into methods, that return a
+	// resumable Sequence. Values are computed lazily, when the sequence is
+	// iterated. The yield statements are kept and have to be generated by
+	// the backend.
+	GenerateYields
+)
+
+// SequenceTypeName is the name of the type, that is returned by methods
+// which are lowered with the GenerateYields strategy.
+const SequenceTypeName = "Sequence"
+
+// YieldListName is the name of the list, that collects the yielded values.
+// It is a valid identifier in the generated languages. Variables are named
+// in lowerCamelCase, the snake case name thus does not collide with them.
+const YieldListName = "yielded_values"
+
+const (
+	yieldStrategyKey         = "lowering.yieldStrategy"
+	backendStrategyKeyPrefix = "lowering.yieldStrategy."
+)
+
+// RegisterYieldStrategy registers the strategy, that the backend with the
+// passed name supports for lowering yielding methods. Backends that do not
+// register a strategy get methods that collect their yielded values.
+func RegisterYieldStrategy(backendName string, strategy YieldStrategy) {
+	isolate.RegisterConfigurator(func(isolate *isolate.Isolate) {
+		isolate.Properties.Insert(backendStrategyKeyPrefix+backendName, strategy)
+	})
+}
+
+// SelectBackend selects the yield strategy for every lowering that is run in
+// the isolate. Methods are only lowered into lazy sequences, if the options
+// enable them and the backend supports them. Otherwise the yielded values
+// are collected, which every backend can generate.
+func SelectBackend(isolate *isolate.Isolate, backendName string, options backend.Options) {
+	strategy := CollectYields
+	if property, ok := isolate.Properties.Lookup(backendStrategyKeyPrefix + backendName); ok {
+		if options.LazySequences {
+			strategy = property.(YieldStrategy)
+		}
+	}
+	isolate.Properties.Insert(yieldStrategyKey, strategy)
+}
+
+func selectedYieldStrategy(isolate *isolate.Isolate) YieldStrategy {
+	if property, ok := isolate.Properties.Lookup(yieldStrategyKey); ok {
+		return property.(YieldStrategy)
+	}
+	return CollectYields
+}
+
+func init() {
+	passes.Register(&YieldLowering{})
+}
+
+// YieldLowering lowers methods that yield values, using the strategy of the
+// selected backend. Methods which do not return a list have already been
+// reported by the semantic analysis and are not lowered.
+type YieldLowering struct{}
+
+func (lowering *YieldLowering) Run(context *passes.Context) {
+	strategy := selectedYieldStrategy(context.Isolate)
+	for _, method := range findYieldingMethods(context.Unit) {
+		if returnType, ok := resolveListReturnType(method); ok {
+			lowerYieldingMethod(method, returnType, strategy)
+		}
+	}
+}
+
+func (lowering *YieldLowering) Id() passes.Id {
+	return YieldLoweringPassId
+}
+
+func (lowering *YieldLowering) Dependencies(isolate *isolate.Isolate) passes.Set {
+	return passes.ListInIsolate(isolate, semantic.NameResolutionPassId)
+}
+
+func findYieldingMethods(unit *tree.TranslationUnit) (methods []*tree.MethodDeclaration) {
+	unit.AcceptRecursive(tree.VisitWith(func(node tree.Node) {
+		if method, ok := node.(*tree.MethodDeclaration); ok && isYielding(method) {
+			methods = append(methods, method)
+		}
+	}))
+	return methods
+}
+
+func isYielding(method *tree.MethodDeclaration) bool {
+	return len(findYieldStatements(method)) != 0
+}
+
+func findYieldStatements(method *tree.MethodDeclaration) (yields []*tree.YieldStatement) {
+	visitor := tree.NewEmptyVisitor()
+	visitor.YieldStatementVisitor = func(statement *tree.YieldStatement) {
+		yields = append(yields, statement)
+	}
+	method.AcceptRecursive(visitor)
+	return yields
+}
+
+func findReturnStatements(method *tree.MethodDeclaration) (returns []*tree.ReturnStatement) {
+	visitor := tree.NewEmptyVisitor()
+	visitor.ReturnStatementVisitor = func(statement *tree.ReturnStatement) {
+		returns = append(returns, statement)
+	}
+	method.AcceptRecursive(visitor)
+	return returns
+}
+
+func resolveListReturnType(method *tree.MethodDeclaration) (*scope.Class, bool) {
+	symbol, ok := scope.AsMethodSymbol(method.Name.Binding())
+	if !ok || symbol.ReturnType == nil || !scope.IsListClass(symbol.ReturnType) {
+		return nil, false
+	}
+	return symbol.ReturnType, true
+}
+
+func lowerYieldingMethod(
+	method *tree.MethodDeclaration, returnType *scope.Class, strategy YieldStrategy) {
+
+	body, ok := method.Body.(*tree.StatementBlock)
+	if !ok {
+		return
+	}
+	if strategy == GenerateYields {
+		lowerIntoGenerator(method, returnType)
+	} else {
+		lowerIntoCollection(method, body, returnType)
+	}
+}
+
+// lowerIntoGenerator replaces the return type of the method with a Sequence
+// of the yielded values. Its yield statements are kept.
+func lowerIntoGenerator(method *tree.MethodDeclaration, returnType *scope.Class) {
+	region := method.Type.Locate()
+	element := scope.ElementClass(returnType)
+	elementName := tree.ParseTypeName(region, element.ActualClass)
+	sequence := &tree.GenericTypeName{
+		Name:      SequenceTypeName,
+		Arguments: []*tree.Generic{tree.NewTypeNameGeneric(elementName)},
+		Region:    region,
+		Parent:    method,
+	}
+	elementName.SetEnclosingNode(sequence)
+	method.Type = sequence
+}
+
+// lowerIntoCollection declares a list at the begin of the method, to which
+// every yielded value is appended. The list is returned by every return
+// statement and at the end of the method. Yield statements, that are not
+// directly inside of a block, are kept.
+func lowerIntoCollection(
+	method *tree.MethodDeclaration, body *tree.StatementBlock, returnType *scope.Class) {
+
+	for _, statement := range findYieldStatements(method) {
+		if block, ok := resolveParentBlock(statement); ok {
+			block.ReplaceExact(statement, createAppendStatement(statement, returnType, block))
+		}
+	}
+	for _, statement := range findReturnStatements(method) {
+		statement.Value = createYieldListReference(statement, returnType)
+	}
+	if !endsWithReturn(body) {
+		body.Append(createYieldListReturn(body, returnType))
+	}
+	body.Prepend(createYieldListDeclaration(body, returnType))
+}
+
+func endsWithReturn(body *tree.StatementBlock) bool {
+	if len(body.Children) == 0 {
+		return false
+	}
+	_, ok := body.Children[len(body.Children)-1].(*tree.ReturnStatement)
+	return ok
+}
+
+func createYieldListReference(parent tree.Node, returnType *scope.Class) *tree.Identifier {
+	identifier := &tree.Identifier{
+		Value:  YieldListName,
+		Region: parent.Locate(),
+		Parent: parent,
+	}
+	identifier.ResolveType(returnType)
+	return identifier
+}
+
+func createYieldListDeclaration(
+	body *tree.StatementBlock, returnType *scope.Class) *tree.AssignStatement {
+
+	region := body.Locate()
+	assign := &tree.AssignStatement{
+		Operator: token.AssignOperator,
+		Region:   region,
+		Parent:   body,
+	}
+	value := &tree.ListExpression{Region: region, Parent: assign}
+	value.ResolveType(returnType)
+	assign.Value = value
+	field := &tree.FieldDeclaration{
+		TypeName: tree.ParseTypeName(region, returnType.ActualClass),
+		Region:   region,
+		Parent:   assign,
+		Inferred: true,
+	}
+	field.Name = createYieldListReference(field, returnType)
+	assign.Target = field
+	return assign
+}
+
+func createYieldListReturn(
+	body *tree.StatementBlock, returnType *scope.Class) *tree.ReturnStatement {
+
+	statement := &tree.ReturnStatement{Region: body.Locate(), Parent: body}
+	statement.Value = createYieldListReference(statement, returnType)
+	return statement
+}
+
+// createAppendStatement creates the call of the lists Add method, which
+// appends the yielded value.
+func createAppendStatement(
+	yield *tree.YieldStatement,
+	returnType *scope.Class,
+	parent tree.Node) *tree.ExpressionStatement {
+
+	statement := &tree.ExpressionStatement{Parent: parent}
+	chain := &tree.ChainExpression{Region: yield.Region, Parent: statement}
+	call := &tree.CallExpression{
+		Target: createAddMethodReference(yield, returnType),
+		Region: yield.Region,
+		Parent: chain,
+	}
+	call.Target.SetEnclosingNode(call)
+	call.Arguments = tree.CallArgumentList{
+		{Value: yield.Value, Region: yield.Value.Locate(), Parent: call},
+	}
+	yield.Value.SetEnclosingNode(call.Arguments[0])
+	call.ResolveType(scope.Builtins.Void)
+	chain.Expressions = []tree.Expression{
+		createYieldListReference(chain, returnType), call,
+	}
+	statement.Expression = chain
+	return statement
+}
+
+func createAddMethodReference(yield *tree.YieldStatement, returnType *scope.Class) *tree.Identifier {
+	identifier := &tree.Identifier{Value: "Add", Region: yield.Region}
+	point := scope.NewReferencePoint(identifier.Value)
+	if entries := returnType.Scope.Lookup(point); len(entries) != 0 {
+		identifier.Bind(entries[0].Symbol)
+	}
+	identifier.ResolveType(scope.Builtins.Void)
+	return identifier
+}
@@ -0,0 +1,89 @@
+package lowering
+
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/analysis"
+	"github.com/strict-lang/sdk/pkg/compiler/analysis/entering"
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/syntax"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	isolates "github.com/strict-lang/sdk/pkg/compiler/isolate"
+	passes "github.com/strict-lang/sdk/pkg/compiler/pass"
+	"github.com/strict-lang/sdk/pkg/compiler/scope"
+)
+
+const yieldingMethod = `
+method Doubled(numbers Number[]) returns Number[]
+  for number in numbers
+    if number < 0
+      return
+    yield number * 2
+`
+
+func lowerYields(testing *testing.T, strategy YieldStrategy) *tree.MethodDeclaration {
+	result := syntax.ParseString("Test", yieldingMethod)
+	if result.Error != nil {
+		testing.Fatalf("failed to parse Unit: %v", result.Error)
+	}
+	isolate := isolates.New()
+	importScope := scope.NewOuterScope("test-scope", scope.NewBuiltinScope())
+	importScope.Insert(&scope.Class{DeclarationName: "Test", QualifiedName: "Test"})
+	testAnalysis := analysis.Analysis{
+		ImportScope:    importScope,
+		NamespaceScope: importScope,
+	}
+	testAnalysis.Store(isolate)
+	isolate.Properties.Insert(yieldStrategyKey, strategy)
+	context := &passes.Context{
+		Unit:       result.TranslationUnit,
+		Diagnostic: diagnostic.NewBag(),
+		Isolate:    isolate,
+	}
+	if err := entering.Run(context); err != nil {
+		testing.Fatal(err)
+	}
+	if err := passes.RunWithId(YieldLoweringPassId, context); err != nil {
+		testing.Fatal(err)
+	}
+	method, ok := result.TranslationUnit.Class.Children[0].(*tree.MethodDeclaration)
+	if !ok {
+		testing.Fatal("expected the unit to contain the method")
+	}
+	return method
+}
+
+func TestYieldLowering_CollectsYieldedValues(testing *testing.T) {
+	method := lowerYields(testing, CollectYields)
+	if len(findYieldStatements(method)) != 0 {
+		testing.Error("expected every yield statement to be lowered")
+	}
+	body := method.Body.(*tree.StatementBlock)
+	if _, ok := body.Children[0].(*tree.AssignStatement); !ok {
+		testing.Error("expected the list to be declared at the begin of the method")
+	}
+	returns := findReturnStatements(method)
+	if len(returns) != 2 {
+		testing.Fatalf("expected 2 return statements, got %d", len(returns))
+	}
+	for _, statement := range returns {
+		identifier, ok := statement.Value.(*tree.Identifier)
+		if !ok || identifier.Value != YieldListName {
+			testing.Error("expected every return statement to return the list")
+		}
+	}
+}
+
+func TestYieldLowering_GeneratesSequences(testing *testing.T) {
+	method := lowerYields(testing, GenerateYields)
+	if len(findYieldStatements(method)) != 1 {
+		testing.Error("expected the yield statement to be kept")
+	}
+	sequence, ok := method.Type.(*tree.GenericTypeName)
+	if !ok || sequence.Name != SequenceTypeName || len(sequence.Arguments) != 1 {
+		testing.Fatalf("expected the method to return a Sequence")
+	}
+	if name := sequence.Arguments[0].Expression.(*tree.Identifier).Value; name != "Number" {
+		testing.Errorf("expected a Sequence of Number, got %s", name)
+	}
+}
@@ -5,15 +5,43 @@ import (
 	"github.com/fatih/color"
 	"github.com/strict-lang/sdk/pkg/compiler/input"
 	"github.com/strict-lang/sdk/pkg/compiler/input/linemap"
+	"sort"
 	"strings"
 )
 
+// contextLineCount is the number of lines, that are rendered before and after
+// every region to show the code surrounding it.
+const contextLineCount = 1
+
+// maximumRegionLineCount is the number of lines, up to which regions are
+// rendered completely. Only the first and last lines of longer regions are
+// rendered, the lines in between are elided.
+const maximumRegionLineCount = 8
+
+const (
+	primaryMarker   = '^'
+	secondaryMarker = '-'
+	elisionGutter   = "..."
+)
+
+var labelColor = color.New(color.FgBlue)
+
 type diagnosticRendering struct {
-	diagnostic   Diagnostic
-	color        *color.Color
-	buffer       *strings.Builder
-	regionInLine input.Region
-	line         input.Line
+	diagnostic  Diagnostic
+	color       *color.Color
+	buffer      *strings.Builder
+	lineMap     *linemap.LineMap
+	annotations []annotation
+}
+
+// annotation is a region that is underlined in the rendered source. The
+// primary annotation is the region of the diagnostic itself, while secondary
+// annotations are its labels and are followed by their message.
+type annotation struct {
+	region  PositionRange
+	marker  rune
+	message string
+	color   *color.Color
 }
 
 func newDiagnosticRendering(
@@ -26,62 +54,59 @@ func newDiagnosticRendering(
 		err := fmt.Errorf("could not find line-map for %s", diagnostic.TextRange.File)
 		return diagnosticRendering{}, err
 	}
-	line := findLineForDiagnostic(diagnostic, lineMap)
-	region := createRelativeRegion(diagnostic.TextRange.Range, line)
 	return diagnosticRendering{
-		diagnostic:   diagnostic,
-		color:        color,
-		buffer:       &strings.Builder{},
-		regionInLine: region,
-		line:         line,
+		diagnostic:  diagnostic,
+		color:       color,
+		buffer:      &strings.Builder{},
+		lineMap:     lineMap,
+		annotations: createAnnotations(diagnostic, color),
 	}, nil
 }
 
-func findLineForDiagnostic(diagnostic Diagnostic, lineMap *linemap.LineMap) input.Line {
-	textRange := diagnostic.TextRange.Range
-	lineIndex := textRange.BeginPosition.Line
-	return lineMap.LineAtIndex(input.LineIndex(lineIndex - 1))
-}
-
-func createRelativeRegion(region PositionRange, line input.Line) input.Region {
-	length := findFixedLength(line)
-	begin := region.BeginPosition.Column
-	if region.EndPosition.Line == region.BeginPosition.Line &&
-		region.EndPosition.Offset != region.BeginPosition.Offset {
-		end := minimum(region.EndPosition.Column, length+1)
-		return input.CreateRegion(input.Offset(begin), input.Offset(end))
-	}
-	return input.CreateRegion(input.Offset(begin), input.Offset(length)+1)
-}
-
-func findFixedLength(line input.Line) int {
-	if strings.HasSuffix(line.Text, "\n") {
-		return len(line.Text) - 1
-	}
-	return len(line.Text) - 1
-}
-
-func minimum(left, right int) int {
-	if left > right {
-		return right
+func createAnnotations(diagnostic Diagnostic, primaryColor *color.Color) []annotation {
+	annotations := []annotation{{
+		region: diagnostic.TextRange.Range,
+		marker: primaryMarker,
+		color:  primaryColor,
+	}}
+	for _, label := range diagnostic.Labels {
+		annotations = append(annotations, annotation{
+			region:  label.Range,
+			marker:  secondaryMarker,
+			message: label.Message,
+			color:   labelColor,
+		})
 	}
-	return left
+	return annotations
 }
 
 func (rendering *diagnosticRendering) print() string {
 	rendering.buffer.WriteString(rendering.description())
 	rendering.buffer.WriteString(rendering.lineInformation())
-	rendering.buffer.WriteString("| ")
-	rendering.buffer.WriteString(rendering.highlightRegionInLine())
-	if underscore := rendering.underscoreRegion(); isBlank(underscore) {
-		rendering.buffer.WriteString("| ")
-		rendering.buffer.WriteString(underscore)
-	}
+	rendering.buffer.WriteString(rendering.source())
+	rendering.buffer.WriteString(rendering.fixes())
 	return rendering.buffer.String()
 }
 
+// fixes lists the titles of the diagnostics fixes and marks the preferred
+// one, which is applied when building with the --fix flag.
+func (rendering *diagnosticRendering) fixes() string {
+	var fixes strings.Builder
+	for index, fix := range rendering.diagnostic.Fixes {
+		if index == 0 {
+			fixes.WriteString(fmt.Sprintf("= fix: %s (applied by --fix)\n", fix.Title))
+		} else {
+			fixes.WriteString(fmt.Sprintf("= fix: %s\n", fix.Title))
+		}
+	}
+	return fixes.String()
+}
+
 func (rendering *diagnosticRendering) description() string {
 	name := rendering.color.Sprintf("[%s]", rendering.diagnostic.Kind)
+	if code := rendering.diagnostic.Code; code != "" {
+		name = rendering.color.Sprintf("[%s %s]", rendering.diagnostic.Kind, code)
+	}
 	return fmt.Sprintf("%s %s\n", name, rendering.diagnostic.Message)
 }
 
@@ -95,48 +120,137 @@ func (rendering *diagnosticRendering) lineInformation() string {
 		textRange.BeginPosition.Column)
 }
 
-func isBlank(text string) bool {
-	for _, character := range text {
-		if character != ' ' {
-			return false
+// source renders the lines of every annotation with a gutter, that contains
+// their line numbers. Each line is followed by the underlines of the
+// annotations in it. Lines that are not rendered are elided.
+func (rendering *diagnosticRendering) source() string {
+	lines := rendering.selectLines()
+	if len(lines) == 0 {
+		return ""
+	}
+	gutterWidth := len(fmt.Sprint(lines[len(lines)-1]))
+	var source strings.Builder
+	emptyGutter := strings.Repeat(" ", gutterWidth) + " |"
+	source.WriteString(emptyGutter + "\n")
+	for index, lineIndex := range lines {
+		if index > 0 && lines[index-1] != lineIndex-1 {
+			source.WriteString(elisionGutter + "\n")
+		}
+		line := rendering.lineMap.LineAtIndex(input.LineIndex(lineIndex))
+		text := []rune(strings.TrimRight(line.Text, "\r\n"))
+		source.WriteString(fmt.Sprintf("%*d | %s\n", gutterWidth, lineIndex, string(text)))
+		for _, annotation := range rendering.annotations {
+			if underline, ok := annotation.underline(lineIndex, text); ok {
+				source.WriteString(emptyGutter + " " + underline + "\n")
+			}
+		}
+	}
+	source.WriteString(emptyGutter + "\n")
+	return source.String()
+}
+
+// selectLines selects the numbers of the rendered lines in ascending order.
+// Annotations that are not located in any line are not rendered.
+func (rendering *diagnosticRendering) selectLines() []int {
+	selected := map[int]bool{}
+	lineCount := rendering.lineMap.LineCount()
+	selectRange := func(begin, end int) {
+		for line := maximum(begin, 1); line <= end && line <= lineCount; line++ {
+			selected[line] = true
+		}
+	}
+	for _, annotation := range rendering.annotations {
+		begin := annotation.region.BeginPosition.Line
+		end := maximum(annotation.region.EndPosition.Line, begin)
+		if begin <= 0 {
+			continue
+		}
+		if end-begin < maximumRegionLineCount {
+			selectRange(begin-contextLineCount, end+contextLineCount)
+			continue
+		}
+		halfRegion := maximumRegionLineCount / 2
+		selectRange(begin-contextLineCount, begin+halfRegion-1)
+		selectRange(end-halfRegion+1, end+contextLineCount)
+	}
+	lines := make([]int, 0, len(selected))
+	for line := range selected {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+	return lines
+}
+
+// underline underlines the columns of the line, that are covered by the
+// annotation. The first and last line of a region are only covered from its
+// begin and until its end, while the lines in between are covered from their
+// first non-whitespace character. The message of the annotation is appended
+// to the underline in the last line of its region.
+func (annotation annotation) underline(lineIndex int, text []rune) (string, bool) {
+	begin, end, ok := annotation.coveredColumns(lineIndex, text)
+	if !ok {
+		return "", false
+	}
+	var underline strings.Builder
+	for _, character := range text[:minimum(begin, len(text))] {
+		if character == '\t' {
+			underline.WriteRune('\t')
+		} else {
+			underline.WriteRune(' ')
 		}
 	}
-	return true
+	underline.WriteString(strings.Repeat(" ", maximum(begin-len(text), 0)))
+	markers := strings.Repeat(string(annotation.marker), end-begin)
+	underline.WriteString(annotation.color.Sprint(markers))
+	if annotation.message != "" && lineIndex == annotation.lastLine() {
+		underline.WriteString(" " + annotation.color.Sprint(annotation.message))
+	}
+	return underline.String(), true
 }
 
-func (rendering *diagnosticRendering) highlightRegionInLine() string {
-	if rendering.isFullLineInRegion() || len(rendering.line.Text) == 0 {
-		colored := rendering.color.Sprint(rendering.line.Text)
-		return withNewLine(colored)
+func (annotation annotation) lastLine() int {
+	return maximum(annotation.region.EndPosition.Line, annotation.region.BeginPosition.Line)
+}
+
+func (annotation annotation) coveredColumns(lineIndex int, text []rune) (int, int, bool) {
+	firstLine := annotation.region.BeginPosition.Line
+	lastLine := annotation.lastLine()
+	if lineIndex < firstLine || lineIndex > lastLine {
+		return 0, 0, false
+	}
+	begin := findIndent(text)
+	if lineIndex == firstLine {
+		begin = annotation.region.BeginPosition.Column
+	}
+	end := len(text)
+	if lineIndex == lastLine && lastLine == annotation.region.EndPosition.Line {
+		end = minimum(annotation.region.EndPosition.Column, len(text))
 	}
-	fullText := rendering.line.Text
-	region := rendering.regionInLine
-	end := minimum(len(fullText), int(region.End()))
-	codeBeforeRegion := fullText[:region.Begin()]
-	codeInRegion := fullText[region.Begin():end]
-	codeAfterRegion := fullText[end:]
-	coloredCodeInRegion := rendering.color.Sprint(codeInRegion)
-	return withNewLine(codeBeforeRegion + coloredCodeInRegion + codeAfterRegion)
+	if lineIndex == firstLine && end <= begin {
+		return begin, begin + 1, true
+	}
+	return begin, end, end > begin
 }
 
-func withNewLine(text string) string {
-	if strings.HasSuffix(text, "\n") {
-		return text
+func findIndent(text []rune) int {
+	for index, character := range text {
+		if character != ' ' && character != '\t' {
+			return index
+		}
 	}
-	return text + "\n"
+	return len(text)
 }
 
-func (rendering *diagnosticRendering) underscoreRegion() string {
-	offsetToRegion := int(rendering.regionInLine.Begin())
-	offsetText := strings.Repeat(" ", offsetToRegion)
-	end := minimum(len(rendering.line.Text), int(rendering.regionInLine.End()))
-	regionSize := end - int(rendering.regionInLine.Begin())
-	underscore := strings.Repeat("^", regionSize)
-	coloredUnderscore := rendering.color.Sprint(underscore)
-	return offsetText + coloredUnderscore
+func minimum(left, right int) int {
+	if left > right {
+		return right
+	}
+	return left
 }
 
-func (rendering *diagnosticRendering) isFullLineInRegion() bool {
-	return rendering.regionInLine.Begin() == 0 &&
-		rendering.regionInLine.End() == rendering.line.Length
+func maximum(left, right int) int {
+	if left < right {
+		return right
+	}
+	return left
 }
@@ -0,0 +1,130 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/strict-lang/sdk/pkg/compiler/input"
+	"github.com/strict-lang/sdk/pkg/compiler/input/linemap"
+)
+
+func createLineMapTable(file string, code string) *linemap.Table {
+	builder := linemap.NewBuilder()
+	offset := 0
+	for _, line := range strings.Split(code, "\n") {
+		length := len([]rune(line))
+		builder.Append(line, input.Offset(offset), input.Offset(length))
+		offset += length + 1
+	}
+	return linemap.NewTable(map[string]*linemap.LineMap{file: builder.NewLineMap()})
+}
+
+func createRange(beginLine, beginColumn, endLine, endColumn int) PositionRange {
+	return PositionRange{
+		BeginPosition: Position{Line: beginLine, Column: beginColumn},
+		EndPosition:   Position{Line: endLine, Column: endColumn},
+	}
+}
+
+func renderDiagnostic(testing *testing.T, diagnostic Diagnostic, code string) string {
+	color.NoColor = true
+	table := createLineMapTable(diagnostic.TextRange.File, code)
+	rendering, err := newDiagnosticRendering(diagnostic, color.New(color.FgRed), table)
+	if err != nil {
+		testing.Fatal(err)
+	}
+	return rendering.print()
+}
+
+const renderedCode = `method run()
+  let value = 1
+  log(value)
+  log(value)
+  log(value)
+  let value = 2
+  if value > 0
+    log(value)
+  return`
+
+func TestDiagnosticRendering_LabelsRegions(testing *testing.T) {
+	rendered := renderDiagnostic(testing, Diagnostic{
+		Message: "collision for name value",
+		Kind:    DiagnosticError,
+		Code:    "S0001",
+		TextRange: TextRange{
+			File:  "Test.strict",
+			Range: createRange(6, 6, 6, 11),
+		},
+		Labels: []Label{{Message: "first declared here", Range: createRange(2, 6, 2, 11)}},
+	}, renderedCode)
+	expected := `[error S0001] collision for name value
+in Test.strict 6:6
+  |
+1 | method run()
+2 |   let value = 1
+  |       ----- first declared here
+3 |   log(value)
+...
+5 |   log(value)
+6 |   let value = 2
+  |       ^^^^^
+7 |   if value > 0
+  |
+`
+	if rendered != expected {
+		testing.Errorf("unexpected rendering:\n%s\nexpected:\n%s", rendered, expected)
+	}
+}
+
+func TestDiagnosticRendering_SpansMultipleLines(testing *testing.T) {
+	rendered := renderDiagnostic(testing, Diagnostic{
+		Message:   "the statement is never executed",
+		Kind:      DiagnosticWarning,
+		TextRange: TextRange{File: "Test.strict", Range: createRange(7, 2, 8, 14)},
+	}, renderedCode)
+	expected := `[warning] the statement is never executed
+in Test.strict 7:2
+  |
+6 |   let value = 2
+7 |   if value > 0
+  |   ^^^^^^^^^^^^
+8 |     log(value)
+  |     ^^^^^^^^^^
+9 |   return
+  |
+`
+	if rendered != expected {
+		testing.Errorf("unexpected rendering:\n%s\nexpected:\n%s", rendered, expected)
+	}
+}
+
+func TestDiagnosticRendering_ElidesLongRegions(testing *testing.T) {
+	code := strings.Repeat("  log(value)\n", 20)
+	rendered := renderDiagnostic(testing, Diagnostic{
+		Message:   "long region",
+		Kind:      DiagnosticInfo,
+		TextRange: TextRange{File: "Test.strict", Range: createRange(2, 2, 19, 12)},
+	}, code)
+	lines := strings.Split(rendered, "\n")
+	if !strings.HasPrefix(lines[2], "   |") || !strings.HasPrefix(lines[3], " 1 |") {
+		testing.Errorf("unexpected gutter in rendering:\n%s", rendered)
+	}
+	if strings.Count(rendered, elisionGutter+"\n") != 1 || strings.Contains(rendered, "10 |") {
+		testing.Errorf("expected the middle of the region to be elided:\n%s", rendered)
+	}
+	if !strings.Contains(rendered, "20 |") || !strings.Contains(rendered, " 5 |") {
+		testing.Errorf("expected the first and last lines to be rendered:\n%s", rendered)
+	}
+}
+
+func TestDiagnosticRendering_SkipsUnknownLines(testing *testing.T) {
+	rendered := renderDiagnostic(testing, Diagnostic{
+		Message:   "failed",
+		Kind:      DiagnosticError,
+		TextRange: TextRange{File: "Test.strict"},
+	}, renderedCode)
+	if rendered != "[error] failed\nin Test.strict 0:0\n" {
+		testing.Errorf("unexpected rendering %q", rendered)
+	}
+}
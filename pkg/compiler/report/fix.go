@@ -0,0 +1,124 @@
+package report
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+)
+
+// ApplyFixes applies the preferred fix of every diagnostic to the files, that
+// are edited by the fixes. Only machine-applicable fixes are applied, others
+// have to be reviewed by the user. Fixes are applied in the order of the
+// diagnostics and skipped, if one of their edits overlaps the edit of an
+// applied fix. It returns the number of fixes, that have been applied.
+func ApplyFixes(report Report) (int, error) {
+	selection := &fixSelection{edits: map[string][]Edit{}}
+	for _, diagnostic := range report.Diagnostics {
+		if len(diagnostic.Fixes) != 0 && diagnostic.Fixes[0].MachineApplicable {
+			selection.selectFix(diagnostic.Fixes[0])
+		}
+	}
+	for file, edits := range selection.edits {
+		if err := applyEditsToFile(file, edits); err != nil {
+			return 0, err
+		}
+	}
+	return selection.count, nil
+}
+
+type fixSelection struct {
+	edits map[string][]Edit
+	count int
+}
+
+func (selection *fixSelection) selectFix(fix Fix) {
+	for _, edit := range fix.Edits {
+		if selection.overlaps(edit) {
+			return
+		}
+	}
+	for _, edit := range fix.Edits {
+		selection.edits[edit.File] = append(selection.edits[edit.File], edit)
+	}
+	selection.count++
+}
+
+func (selection *fixSelection) overlaps(edit Edit) bool {
+	for _, selected := range selection.edits[edit.File] {
+		if isOverlapping(edit.Range, selected.Range) {
+			return true
+		}
+	}
+	return false
+}
+
+// isOverlapping returns true if the ranges share an offset. Empty ranges at
+// the same offset overlap, since the order of their insertions is undefined.
+func isOverlapping(left PositionRange, right PositionRange) bool {
+	leftBegin, leftEnd := left.BeginPosition.Offset, left.EndPosition.Offset
+	rightBegin, rightEnd := right.BeginPosition.Offset, right.EndPosition.Offset
+	return leftBegin == rightBegin || (leftBegin < rightEnd && rightBegin < leftEnd)
+}
+
+func applyEditsToFile(file string, edits []Edit) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	edited, err := applyEdits([]rune(string(content)), edits)
+	if err != nil {
+		return fmt.Errorf("could not fix %s: %v", file, err)
+	}
+	return ioutil.WriteFile(file, []byte(string(edited)), info.Mode())
+}
+
+// applyEdits applies the edits to the content. Edits are applied from the end
+// of the content to its begin, so that the offsets of the remaining edits
+// stay valid.
+func applyEdits(content []rune, edits []Edit) ([]rune, error) {
+	sorted := append([]Edit{}, edits...)
+	sort.Slice(sorted, func(left, right int) bool {
+		return sorted[left].Range.BeginPosition.Offset > sorted[right].Range.BeginPosition.Offset
+	})
+	for _, edit := range sorted {
+		begin, end := edit.Range.BeginPosition.Offset, edit.Range.EndPosition.Offset
+		if begin < 0 || begin > end || end > len(content) {
+			return nil, fmt.Errorf("edit at %d..%d is out of range", begin, end)
+		}
+		if edit.Replacement == "" {
+			begin, end = expandRemovalToLines(content, begin, end)
+		}
+		replaced := append([]rune(edit.Replacement), content[end:]...)
+		content = append(content[:begin], replaced...)
+	}
+	return content, nil
+}
+
+// expandRemovalToLines expands the removed range to the begin and end of its
+// lines, if the removal would otherwise leave a line that only consists of
+// its indentation.
+func expandRemovalToLines(content []rune, begin int, end int) (int, int) {
+	lineBegin := begin
+	for lineBegin > 0 && isIndentation(content[lineBegin-1]) {
+		lineBegin--
+	}
+	if lineBegin != 0 && content[lineBegin-1] != '\n' {
+		return begin, end
+	}
+	switch {
+	case end > begin && content[end-1] == '\n', end == len(content):
+		return lineBegin, end
+	case content[end] == '\n':
+		return lineBegin, end + 1
+	}
+	return begin, end
+}
+
+func isIndentation(character rune) bool {
+	return character == ' ' || character == '\t'
+}
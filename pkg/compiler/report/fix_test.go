@@ -0,0 +1,101 @@
+package report
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func createEdit(file string, begin int, end int, replacement string) Edit {
+	return Edit{
+		File: file,
+		Range: PositionRange{
+			BeginPosition: Position{Offset: begin},
+			EndPosition:   Position{Offset: end},
+		},
+		Replacement: replacement,
+	}
+}
+
+func createSafeFix(title string, edit Edit) Fix {
+	return Fix{Title: title, Edits: []Edit{edit}, MachineApplicable: true}
+}
+
+func TestApplyFixes(testing *testing.T) {
+	directory, err := ioutil.TempDir("", "fixes")
+	if err != nil {
+		testing.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	file := filepath.Join(directory, "Test.strict")
+	source := "import Unused\n\nmethod Run()\n  has ignored Number\n  let valu = 1\n"
+	if err := ioutil.WriteFile(file, []byte(source), 0644); err != nil {
+		testing.Fatal(err)
+	}
+	report := Report{
+		Diagnostics: []Diagnostic{
+			{Fixes: []Fix{createSafeFix("remove import", createEdit(file, 0, 15, ""))}},
+			{Fixes: []Fix{{Title: "remove variable", Edits: []Edit{createEdit(file, 30, 49, "")}}}},
+			{Fixes: []Fix{
+				createSafeFix("rename", createEdit(file, 55, 59, "value")),
+				createSafeFix("alternative", createEdit(file, 55, 59, "values")),
+			}},
+			{Fixes: []Fix{createSafeFix("overlapping", createEdit(file, 56, 58, "x"))}},
+			{},
+		},
+	}
+	applied, err := ApplyFixes(report)
+	if err != nil {
+		testing.Fatal(err)
+	}
+	if applied != 2 {
+		testing.Errorf("expected 2 fixes to be applied, but %d were", applied)
+	}
+	content, _ := ioutil.ReadFile(file)
+	expected := "method Run()\n  has ignored Number\n  let value = 1\n"
+	if string(content) != expected {
+		testing.Errorf("unexpected content %q, expected %q", string(content), expected)
+	}
+}
+
+func TestApplyEdits_RejectsInvalidRanges(testing *testing.T) {
+	edits := []Edit{createEdit("", 2, 10, "")}
+	if _, err := applyEdits([]rune("short"), edits); err == nil {
+		testing.Error("expected an edit outside of the content to be rejected")
+	}
+}
+
+func TestSerializationFormat_EncodesFixes(testing *testing.T) {
+	report := Report{
+		Diagnostics: []Diagnostic{{
+			Message: "could not resolve identifier valu",
+			Kind:    DiagnosticError,
+			Fixes: []Fix{{
+				Title:             "Replace valu with value",
+				Edits:             []Edit{createEdit("Test.strict", 4, 8, "value")},
+				MachineApplicable: true,
+			}},
+		}},
+	}
+	formats := map[string]SerializationFormat{
+		"json": NewJsonSerializationFormat(),
+		"xml":  NewXmlSerializationFormat(),
+	}
+	for name, format := range formats {
+		encoded, err := format.Marshal(report)
+		if err != nil {
+			testing.Fatalf("failed to encode %s: %v", name, err)
+		}
+		decoded, err := format.Unmarshal(encoded)
+		if err != nil {
+			testing.Fatalf("failed to decode %s: %v", name, err)
+		}
+		fixes := decoded.Diagnostics[0].Fixes
+		if len(fixes) != 1 || fixes[0].Title != "Replace valu with value" ||
+			!fixes[0].MachineApplicable ||
+			fixes[0].Edits[0] != report.Diagnostics[0].Fixes[0].Edits[0] {
+			testing.Errorf("fixes were not preserved by %s: %+v", name, fixes)
+		}
+	}
+}
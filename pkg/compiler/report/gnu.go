@@ -0,0 +1,81 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// gnuOutput writes every diagnostic as a single line in the format of the GNU
+// coding standards: `file:line:column: kind: message`. Unlike the rendering
+// output, it is not colored and can be consumed by editors and other tools.
+type gnuOutput struct {
+	report Report
+}
+
+// gnuProgramName prefixes diagnostics, that are not located in any file.
+const gnuProgramName = "strict"
+
+func NewGnuOutput(report Report) Output {
+	return gnuOutput{report: report}
+}
+
+func (output gnuOutput) Print(writer io.Writer) error {
+	for _, diagnostic := range output.report.Diagnostics {
+		if _, err := io.WriteString(writer, formatGnuDiagnostic(diagnostic)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatGnuDiagnostic formats the diagnostic as a single line. Lines and
+// columns start at one and are left out if they are unknown. The code of the
+// diagnostic is appended to its message. Labels are written as notes on the
+// lines following the diagnostic.
+func formatGnuDiagnostic(diagnostic Diagnostic) string {
+	var lines strings.Builder
+	lines.WriteString(formatGnuLocation(diagnostic.TextRange.File, diagnostic.TextRange.Range))
+	lines.WriteString(fmt.Sprintf(" %s: %s", translateToGnuKind(diagnostic.Kind), joinLines(diagnostic.Message)))
+	if diagnostic.Code != "" {
+		lines.WriteString(fmt.Sprintf(" [%s]", diagnostic.Code))
+	}
+	lines.WriteString("\n")
+	for _, label := range diagnostic.Labels {
+		lines.WriteString(formatGnuLocation(diagnostic.TextRange.File, label.Range))
+		lines.WriteString(fmt.Sprintf(" note: %s\n", joinLines(label.Message)))
+	}
+	return lines.String()
+}
+
+// gnuKinds maps the kinds of diagnostics to the kinds, that are known to
+// tools consuming the GNU format. They know no info kind and read it as note.
+var gnuKinds = map[DiagnosticKind]string{
+	DiagnosticError:   "error",
+	DiagnosticWarning: "warning",
+	DiagnosticInfo:    "note",
+}
+
+func translateToGnuKind(kind DiagnosticKind) string {
+	if gnuKind, ok := gnuKinds[kind]; ok {
+		return gnuKind
+	}
+	return string(kind)
+}
+
+func formatGnuLocation(file string, positions PositionRange) string {
+	if file == "" {
+		return gnuProgramName + ":"
+	}
+	begin := positions.BeginPosition
+	if begin.Line <= 0 {
+		return file + ":"
+	}
+	return fmt.Sprintf("%s:%d:%d:", file, begin.Line, begin.Column+1)
+}
+
+// joinLines joins the lines of a message, so that it can be printed on a
+// single line.
+func joinLines(message string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(message, "\n", " ")), " ")
+}
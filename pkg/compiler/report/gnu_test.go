@@ -0,0 +1,47 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGnuOutput(testing *testing.T) {
+	report := Report{
+		Diagnostics: []Diagnostic{
+			{
+				Message: "The method sign does not return a value on every path",
+				Kind:    DiagnosticError,
+				Code:    "S0301",
+				TextRange: TextRange{
+					File: "src/sign.strict",
+					Range: PositionRange{
+						BeginPosition: Position{Line: 1, Column: 7, Offset: 7},
+						EndPosition:   Position{Line: 1, Column: 11, Offset: 11},
+					},
+				},
+				Labels: []Label{{
+					Message: "the method is declared here",
+					Range:   PositionRange{BeginPosition: Position{Line: 1, Column: 0}},
+				}},
+			},
+			{
+				Message:   "cyclic import of namespace B:\nA\nreferences B",
+				Kind:      DiagnosticWarning,
+				TextRange: TextRange{File: "src/a.strict"},
+			},
+			{Message: "could not read build config", Kind: DiagnosticInfo},
+		},
+	}
+	var buffer bytes.Buffer
+	if err := NewGnuOutput(report).Print(&buffer); err != nil {
+		testing.Fatal(err)
+	}
+	expected := "src/sign.strict:1:8: error: " +
+		"The method sign does not return a value on every path [S0301]\n" +
+		"src/sign.strict:1:1: note: the method is declared here\n" +
+		"src/a.strict: warning: cyclic import of namespace B: A references B\n" +
+		"strict: note: could not read build config\n"
+	if buffer.String() != expected {
+		testing.Errorf("unexpected output %q, expected %q", buffer.String(), expected)
+	}
+}
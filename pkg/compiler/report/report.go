@@ -31,6 +31,37 @@ type Diagnostic struct {
 	TextRange TextRange      `json:"textRange"`
 	Message   string         `json:"message"`
 	Kind      DiagnosticKind `json:"kind"`
+	// Code is the stable code of the diagnostic, which is explained by the
+	// explain command.
+	Code string `json:"code,omitempty"`
+	// Fixes are optional changes, that resolve the cause of the diagnostic.
+	// The first fix is the preferred one.
+	Fixes []Fix `json:"fixes,omitempty"`
+	// Labels are secondary regions in the file of the diagnostic, that are
+	// related to its cause.
+	Labels []Label `json:"labels,omitempty"`
+}
+
+// Label is a secondary region of a diagnostic, that is described by the
+// message, for example the first declaration of a colliding name.
+type Label struct {
+	Message string        `json:"message"`
+	Range   PositionRange `json:"range"`
+}
+
+// Fix is a change of the source, that resolves the cause of a diagnostic.
+// Only machine-applicable fixes are applied without being reviewed.
+type Fix struct {
+	Title             string `json:"title"`
+	Edits             []Edit `json:"edits"`
+	MachineApplicable bool   `json:"machineApplicable"`
+}
+
+// Edit replaces the text in the range of the file with the replacement.
+type Edit struct {
+	File        string        `json:"file"`
+	Range       PositionRange `json:"range"`
+	Replacement string        `json:"replacement"`
 }
 
 type TextRange struct {
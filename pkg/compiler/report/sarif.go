@@ -0,0 +1,287 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/strict-lang/sdk/pkg/compiler/diagnostic"
+)
+
+// SARIF is the static analysis results interchange format, that is ingested
+// by most code-scanning tools. The sarifOutput writes the report as a log of
+// version 2.1.0 with a single run of the Strict compiler.
+const (
+	sarifVersion  = "2.1.0"
+	sarifSchema   = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolName = "strict"
+)
+
+type sarifOutput struct {
+	report      Report
+	toolVersion string
+}
+
+// NewSarifOutput creates an output that writes the report in the SARIF
+// format. The version is the version of the compiler, that created the report.
+func NewSarifOutput(report Report, toolVersion string) Output {
+	return sarifOutput{report: report, toolVersion: toolVersion}
+}
+
+func (output sarifOutput) Print(writer io.Writer) error {
+	encoded, err := json.MarshalIndent(output.createLog(), "", prettyJsonIndent)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(encoded)
+	return err
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool sarifTool `json:"tool"`
+	// ColumnKind is the unit of columns and offsets. The offsets of the
+	// report are indices of runes and thus of unicode code points.
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name    string      `json:"name"`
+	Version string      `json:"version,omitempty"`
+	Rules   []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	Id               string        `json:"id"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	FullDescription  *sarifMessage `json:"fullDescription,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleId    string          `json:"ruleId,omitempty"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+	// RelatedLocations are the labels of the diagnostic.
+	RelatedLocations []sarifLocation `json:"relatedLocations,omitempty"`
+	Fixes            []sarifFix      `json:"fixes,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	Message          *sarifMessage         `json:"message,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	Uri string `json:"uri"`
+}
+
+// sarifRegion is a region in an artifact. Lines and columns start at one,
+// while character offsets start at zero.
+type sarifRegion struct {
+	StartLine   int           `json:"startLine,omitempty"`
+	StartColumn int           `json:"startColumn,omitempty"`
+	EndLine     int           `json:"endLine,omitempty"`
+	EndColumn   int           `json:"endColumn,omitempty"`
+	CharOffset  int           `json:"charOffset"`
+	CharLength  int           `json:"charLength"`
+	Snippet     *sarifMessage `json:"snippet,omitempty"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion  `json:"deletedRegion"`
+	InsertedContent sarifMessage `json:"insertedContent"`
+}
+
+var sarifLevels = map[DiagnosticKind]string{
+	DiagnosticError:   "error",
+	DiagnosticWarning: "warning",
+	DiagnosticInfo:    "note",
+}
+
+func (output sarifOutput) createLog() sarifLog {
+	results := make([]sarifResult, len(output.report.Diagnostics))
+	for index, diagnostic := range output.report.Diagnostics {
+		results[index] = translateToSarifResult(diagnostic)
+	}
+	return sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{{
+			Tool: sarifTool{
+				Driver: sarifDriver{
+					Name:    sarifToolName,
+					Version: output.toolVersion,
+					Rules:   output.createRules(),
+				},
+			},
+			ColumnKind: "unicodeCodePoints",
+			Results:    results,
+		}},
+	}
+}
+
+// createRules creates a rule for every code in the report. Rules are
+// described by the explanation of their code, if there is one.
+func (output sarifOutput) createRules() []sarifRule {
+	codes := map[string]bool{}
+	for _, entry := range output.report.Diagnostics {
+		if entry.Code != "" {
+			codes[entry.Code] = true
+		}
+	}
+	rules := make([]sarifRule, 0, len(codes))
+	for code := range codes {
+		rules = append(rules, createSarifRule(code))
+	}
+	sort.Slice(rules, func(left, right int) bool {
+		return rules[left].Id < rules[right].Id
+	})
+	return rules
+}
+
+func createSarifRule(code string) sarifRule {
+	rule := sarifRule{Id: code}
+	if explanation, ok := diagnostic.LookupExplanation(diagnostic.Code(code)); ok {
+		rule.ShortDescription = &sarifMessage{Text: explanation.Title}
+		rule.FullDescription = &sarifMessage{Text: strings.TrimSpace(explanation.Description)}
+	}
+	return rule
+}
+
+func translateToSarifResult(diagnostic Diagnostic) sarifResult {
+	result := sarifResult{
+		RuleId:  diagnostic.Code,
+		Level:   translateToSarifLevel(diagnostic.Kind),
+		Message: sarifMessage{Text: diagnostic.Message},
+		Fixes:   translateToSarifFixes(diagnostic.Fixes),
+	}
+	if diagnostic.TextRange.File != "" {
+		result.Locations = []sarifLocation{translateToSarifLocation(diagnostic.TextRange)}
+		result.RelatedLocations = translateToSarifRelatedLocations(diagnostic)
+	}
+	return result
+}
+
+func translateToSarifRelatedLocations(diagnostic Diagnostic) []sarifLocation {
+	var locations []sarifLocation
+	for _, label := range diagnostic.Labels {
+		region := translateToSarifRegion(label.Range)
+		locations = append(locations, sarifLocation{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: createSarifArtifactLocation(diagnostic.TextRange.File),
+				Region:           &region,
+			},
+			Message: &sarifMessage{Text: label.Message},
+		})
+	}
+	return locations
+}
+
+func translateToSarifLevel(kind DiagnosticKind) string {
+	if level, ok := sarifLevels[kind]; ok {
+		return level
+	}
+	return "error"
+}
+
+func translateToSarifLocation(textRange TextRange) sarifLocation {
+	region := translateToSarifRegion(textRange.Range)
+	if textRange.Text != "" {
+		region.Snippet = &sarifMessage{Text: textRange.Text}
+	}
+	return sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: createSarifArtifactLocation(textRange.File),
+			Region:           &region,
+		},
+	}
+}
+
+// translateToSarifRegion translates the range into a region. The lines of
+// positions start at one and are zero if they are unknown, in which case the
+// region only consists of its character offsets. Columns start at zero.
+func translateToSarifRegion(positions PositionRange) sarifRegion {
+	begin, end := positions.BeginPosition, positions.EndPosition
+	region := sarifRegion{
+		CharOffset: begin.Offset,
+		CharLength: end.Offset - begin.Offset,
+	}
+	if begin.Line > 0 && end.Line > 0 {
+		region.StartLine = begin.Line
+		region.StartColumn = begin.Column + 1
+		region.EndLine = end.Line
+		region.EndColumn = end.Column + 1
+	}
+	return region
+}
+
+// createSarifArtifactLocation creates the location of a file. Uris are always
+// separated by slashes, independent of the operating system.
+func createSarifArtifactLocation(file string) sarifArtifactLocation {
+	return sarifArtifactLocation{Uri: filepath.ToSlash(file)}
+}
+
+func translateToSarifFixes(fixes []Fix) []sarifFix {
+	var translated []sarifFix
+	for _, fix := range fixes {
+		translated = append(translated, sarifFix{
+			Description:     sarifMessage{Text: fix.Title},
+			ArtifactChanges: translateToSarifChanges(fix.Edits),
+		})
+	}
+	return translated
+}
+
+// translateToSarifChanges groups the edits by their file, keeping the order
+// in which the files are first edited.
+func translateToSarifChanges(edits []Edit) []sarifArtifactChange {
+	var changes []sarifArtifactChange
+	indices := map[string]int{}
+	for _, edit := range edits {
+		index, ok := indices[edit.File]
+		if !ok {
+			index = len(changes)
+			indices[edit.File] = index
+			changes = append(changes, sarifArtifactChange{
+				ArtifactLocation: createSarifArtifactLocation(edit.File),
+			})
+		}
+		changes[index].Replacements = append(changes[index].Replacements, sarifReplacement{
+			DeletedRegion:   translateToSarifRegion(edit.Range),
+			InsertedContent: sarifMessage{Text: edit.Replacement},
+		})
+	}
+	return changes
+}
@@ -0,0 +1,87 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestSarifOutput(testing *testing.T) {
+	report := Report{
+		Diagnostics: []Diagnostic{
+			{
+				Message: "could not resolve identifier valu",
+				Kind:    DiagnosticWarning,
+				Code:    "S0101",
+				TextRange: TextRange{
+					Text: "  let x = valu",
+					File: "src/Test.strict",
+					Range: PositionRange{
+						BeginPosition: Position{Line: 2, Column: 10, Offset: 24},
+						EndPosition:   Position{Line: 2, Column: 14, Offset: 28},
+					},
+				},
+				Labels: []Label{{
+					Message: "value is declared here",
+					Range: PositionRange{
+						BeginPosition: Position{Line: 1, Column: 6, Offset: 6},
+						EndPosition:   Position{Line: 1, Column: 11, Offset: 11},
+					},
+				}},
+				Fixes: []Fix{{
+					Title: "Replace valu with value",
+					Edits: []Edit{createEdit("src/Test.strict", 24, 28, "value")},
+				}},
+			},
+			{Message: "failed", Kind: DiagnosticInfo},
+		},
+	}
+	var buffer bytes.Buffer
+	if err := NewSarifOutput(report, "1.0").Print(&buffer); err != nil {
+		testing.Fatal(err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buffer.Bytes(), &log); err != nil {
+		testing.Fatalf("failed to decode sarif log: %v", err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		testing.Fatalf("unexpected log %+v", log)
+	}
+	run := log.Runs[0]
+	if len(run.Tool.Driver.Rules) != 1 || run.Tool.Driver.Rules[0].Id != "S0101" {
+		testing.Errorf("unexpected rules %+v", run.Tool.Driver.Rules)
+	}
+	if len(run.Results) != 2 {
+		testing.Fatalf("expected 2 results but got %d", len(run.Results))
+	}
+	result := run.Results[0]
+	if result.RuleId != "S0101" || result.Level != "warning" ||
+		result.Message.Text != "could not resolve identifier valu" {
+		testing.Errorf("unexpected result %+v", result)
+	}
+	location := result.Locations[0].PhysicalLocation
+	expectedRegion := sarifRegion{
+		StartLine:   2,
+		StartColumn: 11,
+		EndLine:     2,
+		EndColumn:   15,
+		CharOffset:  24,
+		CharLength:  4,
+		Snippet:     location.Region.Snippet,
+	}
+	if location.ArtifactLocation.Uri != "src/Test.strict" || *location.Region != expectedRegion {
+		testing.Errorf("unexpected location %+v", location)
+	}
+	related := result.RelatedLocations
+	if len(related) != 1 || related[0].Message.Text != "value is declared here" ||
+		related[0].PhysicalLocation.Region.StartLine != 1 {
+		testing.Errorf("unexpected related locations %+v", related)
+	}
+	replacement := result.Fixes[0].ArtifactChanges[0].Replacements[0]
+	if replacement.InsertedContent.Text != "value" || replacement.DeletedRegion.CharLength != 4 {
+		testing.Errorf("unexpected replacement %+v", replacement)
+	}
+	if other := run.Results[1]; other.Level != "note" || other.Locations != nil {
+		testing.Errorf("unexpected result %+v", other)
+	}
+}
@@ -0,0 +1,97 @@
+package sad
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/token"
+	"github.com/strict-lang/sdk/pkg/compiler/grammar/tree"
+	"strings"
+)
+
+// formatCondition formats the condition of a contract clause back into its
+// source representation, so it can be shown to the users of the api.
+func formatCondition(expression tree.Expression) string {
+	formatting := newConditionFormatting()
+	expression.Accept(formatting.visitor)
+	return formatting.buffer.String()
+}
+
+type conditionFormatting struct {
+	buffer  *strings.Builder
+	visitor tree.Visitor
+}
+
+func newConditionFormatting() *conditionFormatting {
+	formatting := &conditionFormatting{buffer: &strings.Builder{}}
+	visitor := tree.NewEmptyVisitor()
+	visitor.BinaryExpressionVisitor = formatting.visitBinaryExpression
+	visitor.UnaryExpressionVisitor = formatting.visitUnaryExpression
+	visitor.IdentifierVisitor = formatting.visitIdentifier
+	visitor.NumberLiteralVisitor = formatting.visitNumberLiteral
+	visitor.StringLiteralVisitor = formatting.visitStringLiteral
+	visitor.FieldSelectExpressionVisitor = formatting.visitChainExpression
+	visitor.CallExpressionVisitor = formatting.visitCallExpression
+	formatting.visitor = visitor
+	return formatting
+}
+
+func (formatting *conditionFormatting) write(node tree.Node) {
+	node.Accept(formatting.visitor)
+}
+
+func (formatting *conditionFormatting) visitBinaryExpression(
+	expression *tree.BinaryExpression) {
+
+	formatting.write(expression.LeftOperand)
+	formatting.buffer.WriteString(" ")
+	formatting.buffer.WriteString(formatOperator(expression.Operator))
+	formatting.buffer.WriteString(" ")
+	formatting.write(expression.RightOperand)
+}
+
+func formatOperator(operator token.Operator) string {
+	if keyword, ok := token.KeywordValueOfOperator(operator); ok {
+		return keyword.String()
+	}
+	return operator.String()
+}
+
+func (formatting *conditionFormatting) visitUnaryExpression(
+	expression *tree.UnaryExpression) {
+
+	formatting.buffer.WriteString(formatOperator(expression.Operator))
+	formatting.write(expression.Operand)
+}
+
+func (formatting *conditionFormatting) visitIdentifier(identifier *tree.Identifier) {
+	formatting.buffer.WriteString(identifier.Value)
+}
+
+func (formatting *conditionFormatting) visitNumberLiteral(number *tree.NumberLiteral) {
+	formatting.buffer.WriteString(number.Value)
+}
+
+func (formatting *conditionFormatting) visitStringLiteral(literal *tree.StringLiteral) {
+	formatting.buffer.WriteString(`"` + literal.Value + `"`)
+}
+
+func (formatting *conditionFormatting) visitChainExpression(
+	chain *tree.ChainExpression) {
+
+	for index, element := range chain.Expressions {
+		if index != 0 {
+			formatting.buffer.WriteString(".")
+		}
+		formatting.write(element)
+	}
+}
+
+func (formatting *conditionFormatting) visitCallExpression(call *tree.CallExpression) {
+	formatting.write(call.Target)
+	formatting.buffer.WriteString("(")
+	for index, argument := range call.Arguments {
+		if index != 0 {
+			formatting.buffer.WriteString(", ")
+		}
+		formatting.write(argument.Value)
+	}
+	formatting.buffer.WriteString(")")
+}
@@ -1,34 +1,46 @@
 package sad
 
-import "log"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
-func Decode(input string) *Tree {
-	decoding := &decoding{input:  []rune(input)}
+// Decode decodes an api descriptor, that has been encoded by Encode. It fails
+// if the input is not a valid descriptor.
+func Decode(input string) (*Tree, error) {
+	decoding := &decoding{input: []rune(input)}
 	return decoding.decode()
 }
 
 type decoding struct {
-	input  []rune
-	offset int
+	input   []rune
+	offset  int
 	symbols []string
+	err     error
 }
 
-func (decoding *decoding) decode() *Tree {
+func (decoding *decoding) decode() (*Tree, error) {
 	decoding.symbols = decoding.decodeSymbols()
-	return nil
+	tree := &Tree{}
+	for !decoding.isAtEnd() && decoding.err == nil {
+		tree.Classes = append(tree.Classes, decoding.decodeClass())
+	}
+	return tree, decoding.err
 }
 
-func (decoding *decoding) decodeSymbols() (symbols []string) {
-	for !decoding.isLookingAt(symbolListSeparator) {
-		symbols = append(symbols, decoding.readIdentifier())
-		if !decoding.isLookingAt(symbolListSeparator) {
-			break
-		}
+func (decoding *decoding) decodeSymbols() []string {
+	end := decoding.findIndexOfNext(symbolListSeparator)
+	if end == -1 {
+		decoding.fail("missing end of symbol list")
+		return nil
 	}
-	if decoding.isLookingAt(symbolListSeparator) {
-		decoding.offset++
+	symbols := decoding.extractRange(decoding.offset, end)
+	decoding.offset = end + 1
+	if len(symbols) == 0 {
+		return nil
 	}
-	return symbols
+	return strings.Split(symbols, string(symbolSeparator))
 }
 
 func (decoding *decoding) findIndexOfNext(value rune) int {
@@ -40,165 +52,202 @@ func (decoding *decoding) findIndexOfNext(value rune) int {
 	return -1
 }
 
-func (decoding *decoding) decodeMethod() Method {
-	name := decoding.readIdentifier()
-	decoding.skip('.')
-	parameters := decoding.decodeParameterList()
-	decoding.skip('.')
-	returnType := decoding.decodeClassName()
-	return Method{
-		Name:       name,
-		Parameters: parameters,
-		ReturnType: returnType,
+func (decoding *decoding) decodeClass() *Class {
+	class := &Class{
+		Kind:    decoding.decodeClassKind(),
+		Name:    decoding.readSymbol(),
+		Methods: map[string]Method{},
+		Fields:  map[string]Field{},
 	}
-}
-
-func (decoding *decoding) decodeField() Field {
-	name := decoding.readIdentifier()
-	decoding.skip('.')
-	className := decoding.decodeClassName()
-	return Field{
-		Name:  name,
-		Class: className,
+	decoding.skip(itemSeparator)
+	if decoding.isLookingAt(parameterListBegin) {
+		class.Parameters = decoding.decodeClassParameters()
 	}
+	class.Traits = decoding.decodeTraits()
+	decoding.skip(classItemSeparator)
+	decoding.decodeClassItems(class)
+	decoding.skip(classSeparator)
+	return class
 }
 
-func (decoding *decoding) decodeClass() Class {
-	name := decoding.readIdentifier()
-	methods, fields := decoding.decodeMappedClassItems()
-	return Class{
-		Kind:       0,
-		Traits:     nil,
-		Name:       name,
-		Parameters: nil,
-		Methods:    methods,
-		Fields:     fields,
+func (decoding *decoding) decodeClassKind() TypeKind {
+	switch decoding.current() {
+	case classBeginKey:
+		decoding.offset++
+		return ClassKind
+	case traitBeginKey:
+		decoding.offset++
+		return TraitKind
 	}
+	decoding.fail("invalid class specifier: '%c'", decoding.current())
+	return ClassKind
 }
 
-func (decoding *decoding) decodeParameters() []Parameter {
-	if decoding.isLookingAt(itemSeparator) {
-		decoding.offset++
-		return []Parameter{}
-	}
-	parameters := []Parameter{decoding.decodeParameter()}
-	for decoding.isLookingAt(itemSeparator) {
-		decoding.offset++
-		parameters = append(parameters, decoding.decodeParameter())
+func (decoding *decoding) decodeClassParameters() (parameters []TypeParameter) {
+	decoding.skip(parameterListBegin)
+	decoding.skip(classParameterListBegin)
+	for _, name := range decoding.decodeClassNames(classParameterListEnd) {
+		parameters = append(parameters, TypeParameter{
+			Class:    ClassName{Name: name.Name, Arguments: name.Arguments},
+			Wildcard: name.Wildcard,
+		})
 	}
+	decoding.skip(classParameterListEnd)
+	decoding.skip(parameterListEnd)
 	return parameters
 }
 
 func (decoding *decoding) decodeTraits() []ClassName {
-	if decoding.isLookingAt(itemSeparator) {
-		decoding.offset++
-		return []ClassName{}
+	return decoding.decodeClassNames(classItemSeparator)
+}
+
+// decodeClassNames decodes a list of class names, that are separated by the
+// item separator and followed by the passed end.
+func (decoding *decoding) decodeClassNames(end rune) (names []ClassName) {
+	if decoding.isLookingAt(end) {
+		return nil
 	}
-	traits := []ClassName{decoding.decodeClassName()}
+	names = append(names, decoding.decodeClassName())
 	for decoding.isLookingAt(itemSeparator) {
 		decoding.offset++
-		traits = append(traits, decoding.decodeClassName())
+		names = append(names, decoding.decodeClassName())
 	}
-	return traits
+	return names
 }
 
-func (decoding *decoding) decodeMappedClassItems() (map[string]Method, map[string]Field) {
-	methods := map[string]Method{}
-	fields := map[string]Field{}
-	for _, item := range decoding.decodeClassItems() {
-		if method, isMethod := item.(Method); isMethod {
-			methods[method.Name] = method
-		}
-		if field, isField := item.(Field); isField {
-			fields[field.Name] = field
+func (decoding *decoding) decodeClassItems(class *Class) {
+	for decoding.err == nil {
+		switch decoding.current() {
+		case methodBeginKey:
+			class.AddMethod(decoding.decodeMethod())
+		case fieldBeginKey:
+			field := decoding.decodeField()
+			class.Fields[field.Name] = field
+		default:
+			return
 		}
 	}
-	return methods, fields
 }
 
-func (decoding *decoding) decodeClassItems() []interface{} {
-	if decoding.isLookingAt(classSeparator) {
-		return []interface{}{}
+func (decoding *decoding) decodeMethod() Method {
+	decoding.skip(methodBeginKey)
+	method := Method{
+		Name:       decoding.readSymbol(),
+		Parameters: decoding.decodeParameterList(),
+		ReturnType: decoding.decodeClassName(),
 	}
-	items := []interface{}{decoding.decodeClassItem()}
-	for decoding.isLookingAt(itemSeparator) {
-		decoding.skip(itemSeparator)
-		if decoding.isLookingAt(classSeparator) {
-			break
+	for decoding.err == nil {
+		switch decoding.current() {
+		case preconditionBeginKey:
+			decoding.offset++
+			method.Preconditions = append(method.Preconditions, decoding.readSymbol())
+		case postconditionBeginKey:
+			decoding.offset++
+			method.Postconditions = append(method.Postconditions, decoding.readSymbol())
+		default:
+			decoding.skip(classItemSeparator)
+			return method
 		}
-		items = append(items, decoding.decodeClassItem())
 	}
-	return items
+	return method
+}
+
+func (decoding *decoding) decodeField() Field {
+	decoding.skip(fieldBeginKey)
+	name := decoding.readSymbol()
+	decoding.skip(itemSeparator)
+	field := Field{Name: name, Class: decoding.decodeClassName()}
+	decoding.skip(classItemSeparator)
+	return field
 }
 
-func (decoding *decoding) decodeClassItem() interface{} {
-	if decoding.isLookingAt(methodBeginKey) {
-		return decoding.decodeMethod()
+func (decoding *decoding) decodeParameterList() (parameters []Parameter) {
+	decoding.skip(parameterListBegin)
+	if decoding.isLookingAt(parameterListEnd) {
+		decoding.offset++
+		return nil
 	}
-	if decoding.isLookingAt(fieldBeginKey) {
-		return decoding.decodeField()
+	parameters = append(parameters, decoding.decodeParameter())
+	for decoding.isLookingAt(parameterSeparator) {
+		decoding.offset++
+		parameters = append(parameters, decoding.decodeParameter())
 	}
-	log.Printf("invalid item specifier: '%c'", decoding.current())
-	return nil
+	decoding.skip(parameterListEnd)
+	return parameters
 }
 
-func (decoding *decoding) skip(value rune) {
-	if !decoding.isLookingAt(value) {
-		log.Printf("could not skip '%c'", value)
+// decodeParameter decodes a parameter, that is either encoded as its name
+// and class or as its label, name and class. Since the name is encoded like
+// a class without arguments, the parameter has a label if the class is
+// followed by another item.
+func (decoding *decoding) decodeParameter() Parameter {
+	first := decoding.readSymbol()
+	decoding.skip(itemSeparator)
+	second := decoding.decodeClassName()
+	if !decoding.isLookingAt(itemSeparator) {
+		return Parameter{Name: first, Class: second}
 	}
 	decoding.offset++
+	return Parameter{
+		Label: first,
+		Name:  second.Name,
+		Class: decoding.decodeClassName(),
+	}
 }
 
 func (decoding *decoding) decodeClassName() ClassName {
-	return ClassName{}
+	name := ClassName{}
+	if decoding.isLookingAt(wildcardKey) {
+		decoding.offset++
+		name.Wildcard = true
+		if !isDigit(decoding.current()) {
+			return name
+		}
+	}
+	name.Name = decoding.readSymbol()
+	if decoding.isLookingAt(classParameterListBegin) {
+		decoding.offset++
+		name.Arguments = decoding.decodeClassNames(classParameterListEnd)
+		decoding.skip(classParameterListEnd)
+	}
+	return name
 }
 
-func (decoding *decoding) decodeParameterList() []Parameter {
-	if decoding.isLookingAt(parameterListBegin) {
-		return []Parameter{}
+func (decoding *decoding) readSymbol() string {
+	begin := decoding.offset
+	for isDigit(decoding.current()) {
+		decoding.offset++
 	}
-	parameters := []Parameter{decoding.decodeParameter()}
-	for decoding.isLookingAt(parameterSeparator) {
-		parameters = append(parameters, decoding.decodeParameter())
+	index, err := strconv.Atoi(decoding.extractRange(begin, decoding.offset))
+	if err != nil || index >= len(decoding.symbols) {
+		decoding.fail("invalid symbol at offset %d", begin)
+		return ""
 	}
-	return parameters
+	return decoding.symbols[index]
 }
 
-const parameterWithoutLabelIdentifierCount = 2
-const parameterWithLabelIdentifierCount = 3
-
-func (decoding *decoding) decodeParameter() Parameter {
-	identifiers := decoding.readMultipleIdentifiers()
-	switch len(identifiers) {
-	case parameterWithLabelIdentifierCount:
-	case parameterWithoutLabelIdentifierCount:
+func (decoding *decoding) skip(value rune) {
+	if !decoding.isLookingAt(value) {
+		decoding.fail("expected '%c' at offset %d", value, decoding.offset)
+		return
 	}
-	return Parameter{}
+	decoding.offset++
 }
 
-func (decoding *decoding) readMultipleIdentifiers() []string {
-	identifiers := []string{decoding.readIdentifier()}
-	for !decoding.isLookingAt('.') {
-		decoding.offset++
-		identifiers = append(identifiers, decoding.readIdentifier())
+// fail records the first error of the decoding. Once the decoding failed,
+// the current rune is zero, so that no further items are decoded.
+func (decoding *decoding) fail(format string, arguments ...interface{}) {
+	if decoding.err == nil {
+		decoding.err = fmt.Errorf("invalid api descriptor: "+format, arguments...)
 	}
-	return identifiers
 }
 
 func (decoding *decoding) isLookingAt(value rune) bool {
 	return decoding.current() == value
 }
 
-func (decoding *decoding) readIdentifier() string {
-	if !isIdentifierHead(decoding.current()) {
-		return ""
-	}
-	begin := decoding.offset
-	decoding.offset++
-	for isIdentifierTail(decoding.current()) {
-		decoding.offset++
-	}
-	return decoding.extractRange(begin, decoding.offset)
+func (decoding *decoding) isAtEnd() bool {
+	return decoding.offset >= len(decoding.input)
 }
 
 func (decoding *decoding) extractRange(begin int, end int) string {
@@ -207,19 +256,12 @@ func (decoding *decoding) extractRange(begin int, end int) string {
 }
 
 func (decoding *decoding) current() rune {
+	if decoding.err != nil || decoding.isAtEnd() {
+		return 0
+	}
 	return decoding.input[decoding.offset]
 }
 
-func isIdentifierHead(value rune) bool {
-	return isInRange(value, 'a', 'z') ||
-		isInRange(value, 'A', 'Z')
-}
-
-func isIdentifierTail(value rune) bool {
-	return isIdentifierHead(value) ||
-		isInRange(value, '0', '9')
-}
-
-func isInRange(character rune, begin rune, end rune) bool {
-	return character >= begin && character <= end
+func isDigit(value rune) bool {
+	return value >= '0' && value <= '9'
 }
@@ -0,0 +1,84 @@
+package sad
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeEncodedTree(testing *testing.T) {
+	expected := &Tree{Classes: []*Class{
+		{
+			Kind:   TraitKind,
+			Name:   "Test.Named",
+			Traits: nil,
+			Methods: map[string]Method{
+				"Name": {
+					Name:       "Name",
+					Parameters: []Parameter{{Name: "short", Class: ClassName{Name: "Boolean"}}},
+					ReturnType: ClassName{Name: "String"},
+				},
+			},
+			Fields: map[string]Field{},
+		},
+		{
+			Kind:   ClassKind,
+			Name:   "Test.Box",
+			Traits: []ClassName{{Name: "Test.Named"}},
+			Parameters: []TypeParameter{
+				{Class: ClassName{Name: "Element"}},
+			},
+			Methods: map[string]Method{
+				"Add": {
+					Name: "Add",
+					Parameters: []Parameter{
+						{Name: "element", Class: ClassName{Name: "Element"}},
+						{
+							Label: "at",
+							Name:  "index",
+							Class: ClassName{Name: "Number"},
+						},
+					},
+					ReturnType:    ClassName{Name: "Strict.Base.Void"},
+					Preconditions: []string{"index >= 0"},
+				},
+				"Elements": {
+					Name: "Elements",
+					ReturnType: ClassName{
+						Name:      sliceTypeName,
+						Arguments: []ClassName{{Name: "Element"}},
+					},
+				},
+			},
+			Fields: map[string]Field{
+				"any": {
+					Name: "any",
+					Class: ClassName{
+						Name:      "Test.List",
+						Arguments: []ClassName{{Wildcard: true}},
+					},
+				},
+			},
+		},
+	}}
+	decoded, err := Decode(Encode(expected))
+	if err != nil {
+		testing.Fatalf("failed to decode tree: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, expected) {
+		testing.Errorf("decoded tree %+v differs from the encoded one", decoded)
+	}
+}
+
+func TestDecodeInvalidDescriptor(testing *testing.T) {
+	entries := []string{
+		"",
+		"Test\nx0.;\n",
+		"Test\nc7.;\n",
+		"Test\nc0.;m0(\n",
+	}
+	for _, entry := range entries {
+		if _, err := Decode(entry); err == nil {
+			testing.Errorf("expected decoding of %q to fail", entry)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package sad
 
 import (
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -89,8 +90,12 @@ func (encoding *encoding) writeRune(value rune) {
 }
 
 const methodBeginKey = 'm'
+const preconditionBeginKey = 'r'
+const postconditionBeginKey = 'e'
 const fieldBeginKey = 'f'
 const classBeginKey = 'c'
+const traitBeginKey = 't'
+const wildcardKey = '*'
 const symbolTableBeginKey = 's'
 
 const classParameterListBegin = '<'
@@ -112,8 +117,12 @@ func (encoding *encoding) beginField() {
 	encoding.writeRune(fieldBeginKey)
 }
 
-func (encoding *encoding) beginClass() {
-	encoding.writeRune(classBeginKey)
+func (encoding *encoding) beginClass(kind TypeKind) {
+	if kind == TraitKind {
+		encoding.writeRune(traitBeginKey)
+	} else {
+		encoding.writeRune(classBeginKey)
+	}
 }
 
 const parameterListBegin = '('
@@ -159,7 +168,7 @@ func (encoding *encoding) writeSymbolTable() {
 }
 
 func (class *Class) encode(encoding *encoding) {
-	encoding.beginClass()
+	encoding.beginClass(class.Kind)
 	encoding.writeSymbol(class.Name)
 	encoding.completeItem()
 	class.maybeEncodeParameters(encoding)
@@ -178,15 +187,35 @@ func (class *Class) encodeTraits(encoding *encoding) {
 	}
 }
 
+// encodeItems encodes the methods and fields ordered by their keys, so that
+// the descriptor of a class does not change between builds.
 func (class *Class) encodeItems(encoding *encoding) {
-	for _, method := range class.Methods {
+	for _, key := range class.sortedMethodKeys() {
+		method := class.Methods[key]
 		method.encode(encoding)
 	}
-	for _, field := range class.Fields {
+	for _, key := range class.sortedFieldKeys() {
+		field := class.Fields[key]
 		field.encode(encoding)
 	}
 }
 
+func (class *Class) sortedMethodKeys() (keys []string) {
+	for key := range class.Methods {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (class *Class) sortedFieldKeys() (keys []string) {
+	for key := range class.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (class *Class) maybeEncodeParameters(encoding *encoding) {
 	if len(class.Parameters) != 0 {
 		encoding.beginParameterList()
@@ -197,18 +226,23 @@ func (class *Class) maybeEncodeParameters(encoding *encoding) {
 
 func (class *Class) encodeParameters(encoding *encoding) {
 	encoding.beginClassParameterList()
-	for _, parameter := range class.Parameters {
-		if parameter.Wildcard {
-			encoding.writeSymbol("*")
+	for index, parameter := range class.Parameters {
+		name := ClassName{
+			Name:      parameter.Class.Name,
+			Wildcard:  parameter.Wildcard,
+			Arguments: parameter.Class.Arguments,
+		}
+		name.encode(encoding)
+		if index != len(class.Parameters) - 1 {
+			encoding.completeItem()
 		}
-		encoding.writeSymbol(parameter.Class.Name)
 	}
 	encoding.endClassParameterList()
 }
 
 func (name *ClassName) encode(encoding *encoding) {
 	if name.Wildcard {
-		encoding.writeSymbol("*")
+		encoding.writeRune(wildcardKey)
 		if len(name.Name) == 0 {
 			return
 		}
@@ -235,9 +269,18 @@ func (method *Method) encode(encoding *encoding) {
 	encoding.writeSymbol(method.Name)
 	method.encodeParameters(encoding)
 	method.ReturnType.encode(encoding)
+	encodeConditions(encoding, preconditionBeginKey, method.Preconditions)
+	encodeConditions(encoding, postconditionBeginKey, method.Postconditions)
 	encoding.completeClassItem()
 }
 
+func encodeConditions(encoding *encoding, key rune, conditions []string) {
+	for _, condition := range conditions {
+		encoding.writeRune(key)
+		encoding.writeSymbol(condition)
+	}
+}
+
 func (method *Method) encodeParameters(encoding *encoding) {
 	encoding.beginParameterList()
 	for index, parameter := range method.Parameters {
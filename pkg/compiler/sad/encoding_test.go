@@ -30,7 +30,7 @@ func TestEncode(testing *testing.T) {
 					Body: &tree.StatementBlock{},
 				},
 				&tree.FieldDeclaration{
-					Name:     &tree.Identifier{Value: "log"},
+					Name:     &tree.Identifier{Value: "Log"},
 					TypeName: &tree.ConcreteTypeName{Name: "Strict.Log"},
 				},
 			},
@@ -38,7 +38,7 @@ func TestEncode(testing *testing.T) {
 	})
 	output := Encode(&Tree{Classes: []*Class{tree}})
 	const members = "c0.1;m2(3.4)5;m6()5;f7.8;\n"
-	const symbols = "Test.Test;Test.Super;Run;options;App.Options;Strict.Base.Void;RunX;log;Strict.Log\n"
+	const symbols = "Test.Test;Test.Super;Run;options;App.Options;Strict.Base.Void;RunX;Log;Strict.Log\n"
 	const expected = symbols + members
 	if output != expected {
 		testing.Errorf("unexpected output: \n%s\n expected: \n%s",
@@ -47,6 +47,28 @@ func TestEncode(testing *testing.T) {
 	}
 }
 
+func TestEncodeContract(testing *testing.T) {
+	output := Encode(&Tree{Classes: []*Class{
+		{
+			Name: "Test",
+			Methods: map[string]Method{
+				"Run": {
+					Name:           "Run",
+					ReturnType:     ClassName{Name: "Number"},
+					Preconditions:  []string{"count > 0"},
+					Postconditions: []string{"result isnt 0"},
+				},
+			},
+		},
+	}})
+	const expected = "Test;Run;Number;count > 0;result isnt 0\nc0.;m1()2r3e4;\n"
+	if output != expected {
+		testing.Errorf("unexpected output: \n%s\n expected: \n%s",
+			createBlock(output),
+			createBlock(expected))
+	}
+}
+
 func createBlock(text string) string {
 	longestLineLength := findLongestLineLength(text)
 	separator := strings.Repeat("-", longestLineLength) + "\n"
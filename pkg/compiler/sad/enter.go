@@ -49,14 +49,18 @@ func (entering *entering) enter() {
 	entering.populateClasses()
 }
 
+// createMethodSymbol creates the symbol of a method, that is declared by the
+// class of the binding. Methods of traits have no body and are abstract.
 func (entering *entering) createMethodSymbol(
-	enclosingClass *scope.Class,
+	binding classBinding,
 	method Method) *scope.Method {
 
 	return &scope.Method{
 		DeclarationName: method.Name,
 		ReturnType:      entering.findClass(method.ReturnType),
-		Parameters:      entering.translateParameters(enclosingClass, method.Parameters),
+		Parameters:      entering.translateParameters(binding.symbol, method.Parameters),
+		EnclosingClass:  binding.symbol,
+		Abstract:        binding.class.Kind == TraitKind,
 	}
 }
 
@@ -70,6 +74,7 @@ func (entering *entering) translateParameters(
 			Class:           entering.findClass(parameter.Class),
 			Kind:            scope.ParameterField,
 			EnclosingClass:  enclosingClass,
+			Label:           parameter.Label,
 		}
 		fields = append(fields, field)
 	}
@@ -93,10 +98,14 @@ func (entering *entering) enterClasses() {
 	}
 }
 
+// enterClass enters the class into the scope. Every class has its own scope
+// for its members, which is a child of the entered scope.
 func (entering *entering) enterClass(class *Class) {
+	name := removeQualifier(class.Name)
 	symbol := &scope.Class{
-		Scope:           entering.scope,
-		DeclarationName: removeQualifier(class.Name),
+		Scope:           scope.NewOuterScope(scope.Id(name), entering.scope),
+		DeclarationName: name,
+		QualifiedName:   class.Name,
 		ActualClass:     entering.createTypeInformation(class),
 	}
 	entering.scope.Insert(symbol)
@@ -110,11 +119,11 @@ func (entering *entering) createTypeInformation(class *Class) typing.Type {
 }
 
 func removeQualifier(name string) string {
-	qualifierEnd := strings.Index(name, ".")
+	qualifierEnd := strings.LastIndex(name, ".")
 	if qualifierEnd == -1 {
 		return name
 	}
-	return name[qualifierEnd:]
+	return name[qualifierEnd+1:]
 }
 
 func (entering *entering) populateClasses() {
@@ -124,8 +133,8 @@ func (entering *entering) populateClasses() {
 }
 
 func (entering *entering) populateClassSymbol(binding classBinding) {
-	if concrete, ok := binding.symbol.ActualClass.(*typing.ConcreteType); ok {
-		concrete.Traits = entering.findActualClasses(binding.class.Traits)
+	for _, trait := range entering.findClasses(binding.class.Traits) {
+		scope.AddTrait(binding.symbol, trait)
 	}
 	entering.insertMembers(binding)
 }
@@ -133,7 +142,7 @@ func (entering *entering) populateClassSymbol(binding classBinding) {
 func (entering *entering) insertMembers(binding classBinding) {
 	classScope := binding.symbol.Scope
 	for _, method := range binding.class.Methods {
-		classScope.Insert(entering.createMethodSymbol(binding.symbol, method))
+		classScope.Insert(entering.createMethodSymbol(binding, method))
 	}
 	for _, field := range binding.class.Fields {
 		classScope.Insert(entering.createFieldSymbol(binding.symbol, field))
@@ -141,24 +150,37 @@ func (entering *entering) insertMembers(binding classBinding) {
 }
 
 // TODO: Implement generic types
-func (entering *entering) findActualClasses(names []ClassName) (classes []typing.Type) {
+func (entering *entering) findClasses(names []ClassName) (classes []*scope.Class) {
 	for _, name := range names {
-		class := entering.findClassByName(name.Name)
-		classes = append(classes, class.ActualClass)
+		classes = append(classes, entering.findClassByName(name.Name))
 	}
 	return
 }
 
+// findClass finds the class with the name. Slices are translated to lists
+// and the builtin classes of the Strict.Base namespace, like Void, are found
+// by their unqualified name.
 func (entering *entering) findClass(name ClassName) *scope.Class {
+	if name.Name == sliceTypeName && len(name.Arguments) == 1 {
+		return scope.NewListClass(entering.findClass(name.Arguments[0]))
+	}
 	return entering.findClassByName(name.Name)
 }
 
 func (entering *entering) findClassByName(name string) *scope.Class {
+	if class, ok := entering.lookupClass(name); ok {
+		return class
+	}
+	if class, ok := entering.lookupClass(removeQualifier(name)); ok {
+		return class
+	}
+	return scope.Builtins.Any
+}
+
+func (entering *entering) lookupClass(name string) (*scope.Class, bool) {
 	symbols := entering.scope.Lookup(scope.NewReferencePoint(name))
 	if !symbols.IsEmpty() {
-		if class, ok := scope.AsClassSymbol(symbols.First().Symbol); ok {
-			return class
-		}
+		return scope.AsClassSymbol(symbols.First().Symbol)
 	}
-	return scope.Builtins.Any
+	return nil, false
 }
@@ -60,14 +60,21 @@ func translateKind(unit *tree.TranslationUnit) TypeKind {
 
 func (generation *generation) visitMethod(method *tree.MethodDeclaration) {
 	descriptor := Method{
-		Name:       method.Name.Value,
-		Parameters: translateParameters(method),
-		ReturnType: translateTypeName(method.Type),
+		Name:           method.Name.Value,
+		Parameters:     translateParameters(method),
+		ReturnType:     translateTypeName(method.Type),
+		Preconditions:  translateContractClauses(method.Preconditions()),
+		Postconditions: translateContractClauses(method.Postconditions()),
 	}
-	generation.class.Methods[descriptor.Name] = descriptor
+	generation.class.AddMethod(descriptor)
 }
 
+// visitField adds exported fields to the descriptor. Private fields and
+// local variables can not be referenced by other units.
 func (generation *generation) visitField(field *tree.FieldDeclaration) {
+	if !field.IsExported() {
+		return
+	}
 	descriptor := Field{
 		Name:  field.Name.Value,
 		Class: translateTypeName(field.TypeName),
@@ -75,6 +82,13 @@ func (generation *generation) visitField(field *tree.FieldDeclaration) {
 	generation.class.Fields[descriptor.Name] = descriptor
 }
 
+func translateContractClauses(clauses []*tree.AssertStatement) (conditions []string) {
+	for _, clause := range clauses {
+		conditions = append(conditions, formatCondition(clause.Expression))
+	}
+	return
+}
+
 func translateParameters(method *tree.MethodDeclaration) (parameters []Parameter) {
 	for _, parameter := range method.Parameters {
 		parameters = append(parameters, Parameter{
@@ -37,3 +37,28 @@ func TestGeneration(testing *testing.T) {
 	formatted, _ := json.MarshalIndent(descriptor, "  ", "  ")
 	fmt.Printf("generated descriptor: %v", string(formatted))
 }
+
+func TestGeneration_SkipsPrivateFields(testing *testing.T) {
+	descriptor := newGeneration(&tree.TranslationUnit{
+		Name: "Test.Test",
+		Class: &tree.ClassDeclaration{
+			Name: "Test.Test",
+			Children: []tree.Node{
+				&tree.FieldDeclaration{
+					Name:     &tree.Identifier{Value: "Log"},
+					TypeName: &tree.ConcreteTypeName{Name: "Strict.Log"},
+				},
+				&tree.FieldDeclaration{
+					Name:     &tree.Identifier{Value: "count"},
+					TypeName: &tree.ConcreteTypeName{Name: "Number"},
+				},
+			},
+		},
+	}).Generate()
+	if _, ok := descriptor.Fields["Log"]; !ok {
+		testing.Error("expected the exported field Log to be described")
+	}
+	if _, ok := descriptor.Fields["count"]; ok {
+		testing.Error("expected the private field count to not be described")
+	}
+}
@@ -1,5 +1,7 @@
 package sad
 
+import "strings"
+
 type TypeKind int8
 
 const (
@@ -53,6 +55,30 @@ func (class *Class) FindMethod(name string) (Method, bool) {
 	return Method{}, false
 }
 
+// AddMethod adds the method to the class. The first method with a name is
+// stored under its name, so it can be found by FindMethod. Its overloads are
+// stored under a key, that also contains the labels and classes of their
+// parameters.
+func (class *Class) AddMethod(method Method) {
+	key := method.Name
+	if _, exists := class.Methods[key]; exists {
+		key = method.overloadKey()
+	}
+	class.Methods[key] = method
+}
+
+func (method *Method) overloadKey() string {
+	parameters := make([]string, len(method.Parameters))
+	for index, parameter := range method.Parameters {
+		label := parameter.Label
+		if label == "" {
+			label = parameter.Name
+		}
+		parameters[index] = label + " " + parameter.Class.Name
+	}
+	return method.Name + "(" + strings.Join(parameters, ", ") + ")"
+}
+
 func (class *Class) FindField(name string) (Field, bool) {
 	if field, ok := class.Fields[name]; ok {
 		return field, true
@@ -64,6 +90,10 @@ type Method struct {
 	Name       string
 	Parameters []Parameter
 	ReturnType ClassName
+	// Preconditions and Postconditions are the formatted conditions of the
+	// methods contract. They are only used to document the api.
+	Preconditions  []string
+	Postconditions []string
 }
 
 type Parameter struct {
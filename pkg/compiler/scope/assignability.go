@@ -0,0 +1,64 @@
+package scope
+
+// IsAssignable returns true if values of the first class can be assigned to
+// fields, parameters or return values of the target class. The class Any is
+// assignable to and from every class, since it is used for expressions that
+// failed to resolve and reporting them again would only add noise.
+func IsAssignable(value *Class, target *Class) bool {
+	if value == target || value == Builtins.Any || target == Builtins.Any {
+		return true
+	}
+	if value == Builtins.Number && target == Builtins.Float {
+		return true
+	}
+	if IsGenericInstance(value) || IsGenericInstance(target) {
+		return isInstanceAssignable(value, target)
+	}
+	if value.ActualClass != nil && target.ActualClass != nil {
+		return value.ActualClass.Is(target.ActualClass)
+	}
+	return value.QualifiedName == target.QualifiedName
+}
+
+// IsGenericInstance returns true if the class is an instantiation of a
+// builtin generic class, like lists, maps or results.
+func IsGenericInstance(class *Class) bool {
+	return len(class.Arguments) != 0
+}
+
+// isInstanceAssignable checks the arguments of generic instances. A class,
+// that is not instantiated, is assignable to every instance of the same
+// class. This is the case for the builtin Result, which is returned by the
+// Error method. Instances of other classes are assignable, if their class
+// implements the target. The arguments of instances are invariant, since
+// generic classes do not declare the variance of their parameters.
+func isInstanceAssignable(value *Class, target *Class) bool {
+	if value.DeclarationName != target.DeclarationName {
+		return value.ActualClass != nil && target.ActualClass != nil &&
+			value.ActualClass.Is(target.ActualClass)
+	}
+	if !IsGenericInstance(value) || !IsGenericInstance(target) {
+		return true
+	}
+	if len(value.Arguments) != len(target.Arguments) {
+		return false
+	}
+	for index, argument := range value.Arguments {
+		if !isArgumentAssignable(argument, target.Arguments[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isArgumentAssignable returns true if the arguments are the same class. A
+// list of versions can for example not be used as a list of comparables,
+// since values that are not versions could then be added to it.
+func isArgumentAssignable(value *Class, target *Class) bool {
+	return IsAssignable(value, target) && IsAssignable(target, value)
+}
+
+// IsNumericClass returns true if the class is one of the builtin numbers.
+func IsNumericClass(class *Class) bool {
+	return class == Builtins.Number || class == Builtins.Float
+}
@@ -0,0 +1,147 @@
+package scope
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/typing"
+)
+
+func TestIsAssignable(testing *testing.T) {
+	entries := []struct {
+		value      *Class
+		target     *Class
+		assignable bool
+	}{
+		{Builtins.Number, Builtins.Number, true},
+		{Builtins.Number, Builtins.Float, true},
+		{Builtins.Float, Builtins.Number, false},
+		{Builtins.String, Builtins.Number, false},
+		{Builtins.Any, Builtins.String, true},
+		{Builtins.Boolean, Builtins.Any, true},
+		{NewListClass(Builtins.Number), NewListClass(Builtins.Number), true},
+		{NewListClass(Builtins.Number), NewListClass(Builtins.Float), false},
+		{NewListClass(Builtins.Any), NewListClass(Builtins.String), true},
+		{NewListClass(Builtins.Number), Builtins.Number, false},
+		{NewMapClass(Builtins.String, Builtins.Number),
+			NewMapClass(Builtins.String, Builtins.Number), true},
+		{NewMapClass(Builtins.String, Builtins.Number),
+			NewMapClass(Builtins.Number, Builtins.Number), false},
+		{Builtins.Result, NewResultClass(Builtins.Number), true},
+	}
+	for _, entry := range entries {
+		if IsAssignable(entry.value, entry.target) != entry.assignable {
+			testing.Errorf("expected assignability of %s to %s to be %v",
+				entry.value, entry.target, entry.assignable)
+		}
+	}
+}
+
+func createClassWithTraits(name string, traits ...*Class) *Class {
+	actualClass := &typing.ConcreteType{Name: name}
+	for _, trait := range traits {
+		actualClass.AddTraits(trait.ActualClass)
+	}
+	return &Class{DeclarationName: name, QualifiedName: name, ActualClass: actualClass}
+}
+
+func TestIsAssignableThroughTraits(testing *testing.T) {
+	comparable := createClassWithTraits("Comparable")
+	ordered := createClassWithTraits("Ordered", comparable)
+	version := createClassWithTraits("Version", ordered)
+	first := createClassWithTraits("First")
+	second := createClassWithTraits("Second", first)
+	AddTrait(first, second)
+	entries := []struct {
+		value      *Class
+		target     *Class
+		assignable bool
+	}{
+		{version, ordered, true},
+		{version, comparable, true},
+		{comparable, version, false},
+		{first, second, true},
+		{second, comparable, false},
+		{NewListClass(version), NewListClass(version), true},
+		{NewListClass(version), NewListClass(comparable), false},
+		{NewMapClass(Builtins.String, version), NewMapClass(Builtins.String, comparable), false},
+	}
+	for _, entry := range entries {
+		if IsAssignable(entry.value, entry.target) != entry.assignable {
+			testing.Errorf("expected assignability of %s to %s to be %v",
+				entry.value.Name(), entry.target.Name(), entry.assignable)
+		}
+	}
+}
+
+func TestIsSubtypeOfOptional(testing *testing.T) {
+	number := Builtins.Number.ActualClass
+	optional := &typing.OptionalType{Child: number}
+	if !number.Is(optional) {
+		testing.Error("expected Number to be a subtype of Number?")
+	}
+	if optional.Is(number) {
+		testing.Error("expected Number? to not be a subtype of Number")
+	}
+	if !optional.Is(Builtins.Any.ActualClass) {
+		testing.Error("expected Any to be the top type")
+	}
+}
+
+func TestIsAssignableAfterTraitIsAdded(testing *testing.T) {
+	named := createClassWithTraits("Named")
+	person := createClassWithTraits("Person")
+	if IsAssignable(person, named) {
+		testing.Errorf("expected Person not to be assignable to Named")
+	}
+	AddTrait(person, named)
+	if !IsAssignable(person, named) {
+		testing.Errorf("expected Person to be assignable to Named after adding the trait")
+	}
+}
+
+func TestIsAssignableAfterSuperTraitIsAdded(testing *testing.T) {
+	comparable := createClassWithTraits("Comparable")
+	ordered := createClassWithTraits("Ordered")
+	version := createClassWithTraits("Version", ordered)
+	if IsAssignable(version, comparable) {
+		testing.Errorf("expected Version not to be assignable to Comparable")
+	}
+	AddTrait(ordered, comparable)
+	if !IsAssignable(version, comparable) {
+		testing.Errorf("expected Version to be assignable to Comparable after" +
+			" adding the trait to Ordered")
+	}
+}
+
+func TestIsAssignableToTopTypeOnly(testing *testing.T) {
+	any := createClassWithTraits("Any")
+	if IsAssignable(Builtins.Number, any) {
+		testing.Errorf("expected only the builtin Any to be the top type")
+	}
+}
+
+func TestAddTraitWhileCheckingAssignability(testing *testing.T) {
+	named := createClassWithTraits("Named")
+	persons := make([]*Class, 8)
+	var group sync.WaitGroup
+	for index := range persons {
+		person := createClassWithTraits("Person")
+		persons[index] = person
+		group.Add(2)
+		go func() {
+			defer group.Done()
+			AddTrait(person, named)
+		}()
+		go func() {
+			defer group.Done()
+			IsAssignable(person, named)
+		}()
+	}
+	group.Wait()
+	for _, person := range persons {
+		if !IsAssignable(person, named) {
+			testing.Errorf("expected Person to be assignable to Named")
+		}
+	}
+}
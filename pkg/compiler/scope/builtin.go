@@ -15,8 +15,12 @@ var Builtins = struct {
 	Boolean *Class
 	String  *Class
 	Void    *Class
+	Result  *Class
+	List    *Class
+	Map     *Class
 	True    *Field
 	False   *Field
+	Error   *Method
 }{
 	Void:    createVoidType(),
 	Number:  createNumberType(),
@@ -24,6 +28,7 @@ var Builtins = struct {
 	Any:     createAnyType(),
 	Boolean: booleanType,
 	String:  createStringType(),
+	Result:  createResultType(),
 	True:    createBuiltinField("True", booleanType),
 	False:   createBuiltinField("False", booleanType),
 }
@@ -37,11 +42,20 @@ func init() {
 	builtinScope.Insert(Builtins.False)
 	builtinScope.Insert(Builtins.Void)
 	builtinScope.Insert(Builtins.Any)
+	builtinScope.Insert(Builtins.Result)
+	Builtins.Result.Scope = createResultContents(Builtins.Any)
+	Builtins.Error = createErrorMethod()
+	builtinScope.Insert(Builtins.Error)
+	Builtins.List = NewListClass(Builtins.Any)
+	builtinScope.Insert(Builtins.List)
+	Builtins.Map = NewMapClass(Builtins.Any, Builtins.Any)
+	builtinScope.Insert(Builtins.Map)
+	populateStringContents(Builtins.String.Scope)
 }
 
 func createAnyType() *Class {
 	any := createPrimitiveClass("Any")
-	any.ActualClass = typing.NewEmptyClass("Any")
+	any.ActualClass = typing.NewTopType(any.Name())
 	any.Scope = createAnyContents()
 	return any
 }
@@ -86,6 +100,121 @@ func createStringType() *Class {
 	return class
 }
 
+const (
+	ListClassName = "List"
+	MapClassName  = "Map"
+)
+
+// NewListClass creates the class of a list, which holds elements of the
+// passed class. Every instantiation has its own scope of builtin methods.
+func NewListClass(element *Class) *Class {
+	return &Class{
+		DeclarationName: ListClassName,
+		QualifiedName:   ListClassName,
+		Scope:           createListContents(element),
+		Arguments:       []*Class{element},
+		ActualClass:     &typing.ListType{Child: actualClassOf(element)},
+	}
+}
+
+// NewMapClass creates the class of a map, which associates keys of the first
+// passed class with values of the second passed class.
+func NewMapClass(key *Class, value *Class) *Class {
+	return &Class{
+		DeclarationName: MapClassName,
+		QualifiedName:   MapClassName,
+		Scope:           createMapContents(key, value),
+		Arguments:       []*Class{key, value},
+		ActualClass: &typing.MapType{
+			Key:   actualClassOf(key),
+			Value: actualClassOf(value),
+		},
+	}
+}
+
+func IsListClass(class *Class) bool {
+	return class.DeclarationName == ListClassName && len(class.Arguments) == 1
+}
+
+func IsMapClass(class *Class) bool {
+	return class.DeclarationName == MapClassName && len(class.Arguments) == 2
+}
+
+// ElementClass returns the class of the elements, that are visited when
+// iterating over a value of the passed class. Maps are iterated by their
+// keys and strings by their characters, which are strings themselves.
+func ElementClass(class *Class) *Class {
+	if IsListClass(class) || IsMapClass(class) {
+		return class.Arguments[0]
+	}
+	return class
+}
+
+func createListContents(element *Class) MutableScope {
+	contents := NewOuterScope("Builtin.List", emptyScope)
+	contents.Insert(createBuiltinMethod("Length", Builtins.Number))
+	contents.Insert(createBuiltinMethod("IsEmpty", Builtins.Boolean))
+	contents.Insert(createBuiltinMethod("Contains", Builtins.Boolean,
+		createBuiltinParameter("element", element)))
+	contents.Insert(createBuiltinMethod("IndexOf", Builtins.Number,
+		createBuiltinParameter("element", element)))
+	contents.Insert(createBuiltinMethod("Add", Builtins.Void,
+		createBuiltinParameter("element", element)))
+	contents.Insert(createBuiltinMethod("Remove", Builtins.Void,
+		createBuiltinParameter("element", element)))
+	contents.Insert(createBuiltinMethod("Clear", Builtins.Void))
+	return contents
+}
+
+func createMapContents(key *Class, value *Class) MutableScope {
+	contents := NewOuterScope("Builtin.Map", emptyScope)
+	contents.Insert(createBuiltinMethod("Length", Builtins.Number))
+	contents.Insert(createBuiltinMethod("IsEmpty", Builtins.Boolean))
+	contents.Insert(createBuiltinMethod("Contains", Builtins.Boolean,
+		createBuiltinParameter("key", key)))
+	contents.Insert(createBuiltinMethod("Get", value,
+		createBuiltinParameter("key", key)))
+	contents.Insert(createBuiltinMethod("Put", Builtins.Void,
+		createBuiltinParameter("key", key),
+		createBuiltinParameter("value", value)))
+	contents.Insert(createBuiltinMethod("Remove", Builtins.Void,
+		createBuiltinParameter("key", key)))
+	contents.Insert(createBuiltinMethod("Keys", NewListClass(key)))
+	contents.Insert(createBuiltinMethod("Values", NewListClass(value)))
+	contents.Insert(createBuiltinMethod("Clear", Builtins.Void))
+	return contents
+}
+
+func populateStringContents(contents MutableScope) {
+	contents.Insert(createBuiltinMethod("Length", Builtins.Number))
+	contents.Insert(createBuiltinMethod("IsEmpty", Builtins.Boolean))
+	contents.Insert(createBuiltinMethod("Contains", Builtins.Boolean,
+		createBuiltinParameter("text", Builtins.String)))
+	contents.Insert(createBuiltinMethod("IndexOf", Builtins.Number,
+		createBuiltinParameter("text", Builtins.String)))
+	contents.Insert(createBuiltinMethod("StartsWith", Builtins.Boolean,
+		createBuiltinParameter("prefix", Builtins.String)))
+	contents.Insert(createBuiltinMethod("Substring", Builtins.String,
+		createBuiltinParameter("begin", Builtins.Number),
+		createBuiltinParameter("end", Builtins.Number)))
+}
+
+func createBuiltinMethod(name string, returnType *Class, parameters ...*Field) *Method {
+	return &Method{
+		DeclarationName: name,
+		ReturnType:      returnType,
+		Parameters:      parameters,
+	}
+}
+
+func createBuiltinParameter(name string, class *Class) *Field {
+	return &Field{
+		DeclarationName: name,
+		Class:           class,
+		Kind:            ParameterField,
+	}
+}
+
 func createBuiltinField(name string, class *Class) *Field {
 	return &Field{
 		DeclarationName:   name,
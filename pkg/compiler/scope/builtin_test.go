@@ -0,0 +1,43 @@
+package scope
+
+import "testing"
+
+func TestNewListClass(testing *testing.T) {
+	list := NewListClass(Builtins.String)
+	if !IsListClass(list) {
+		testing.Error("instantiated List is not a List class")
+	}
+	if element := ElementClass(list); element != Builtins.String {
+		testing.Errorf("expected element class String but got %s", element)
+	}
+	entries := list.Scope.Lookup(NewReferencePoint("Contains"))
+	method, ok := entries.First().Symbol.(*Method)
+	if !ok || method.Parameters[0].Class != Builtins.String {
+		testing.Error("Contains method does not take the class of the elements")
+	}
+}
+
+func TestNewMapClass(testing *testing.T) {
+	mapClass := NewMapClass(Builtins.String, Builtins.Number)
+	if !IsMapClass(mapClass) || IsListClass(mapClass) {
+		testing.Error("instantiated Map is not a Map class")
+	}
+	entries := mapClass.Scope.Lookup(NewReferencePoint("Get"))
+	if method, ok := entries.First().Symbol.(*Method); !ok || method.ReturnType != Builtins.Number {
+		testing.Error("Get method does not return the class of the values")
+	}
+	entries = mapClass.Scope.Lookup(NewReferencePoint("Keys"))
+	method, ok := entries.First().Symbol.(*Method)
+	if !ok || ElementClass(method.ReturnType) != Builtins.String {
+		testing.Error("Keys method does not return a List of the keys")
+	}
+}
+
+func TestBuiltinStringMethods(testing *testing.T) {
+	for _, name := range []string{"Length", "Contains", "StartsWith", "Substring"} {
+		entries := Builtins.String.Scope.Lookup(NewReferencePoint(name))
+		if _, ok := entries.First().Symbol.(*Method); !ok {
+			testing.Errorf("String has no builtin method %s", name)
+		}
+	}
+}
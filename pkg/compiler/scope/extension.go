@@ -0,0 +1,36 @@
+package scope
+
+// ExtensionReceiverName is the name of the implicit parameter, that holds
+// the extended value inside of an extension method.
+const ExtensionReceiverName = "self"
+
+// LookupExtension searches the scope for an extension method with the points
+// name, that extends the receiver class. Extension methods are not part of
+// the receivers scope and are only visible, if they have been declared in
+// the same namespace or are imported.
+func LookupExtension(scope Scope, receiver *Class, point ReferencePoint) (*Method, bool) {
+	for _, entry := range scope.Lookup(point) {
+		if method, ok := AsMethodSymbol(entry.Symbol); ok {
+			if method.IsExtension() && method.Receiver.extendedBy(receiver) {
+				return method, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// extendedBy returns true if the receiver is the extended class or one of
+// its instances. Classes are compared by their qualified names, since classes
+// of different namespaces may have the same name.
+func (class *Class) extendedBy(receiver *Class) bool {
+	if class == receiver {
+		return true
+	}
+	return receiver != nil && class.QualifiedName != "" &&
+		class.QualifiedName == receiver.QualifiedName
+}
+
+func filterForExtension(symbol Symbol) bool {
+	method, isMethod := symbol.(*Method)
+	return isMethod && method.IsExtension()
+}
@@ -0,0 +1,40 @@
+package scope
+
+import "testing"
+
+func TestLookupExtension(testing *testing.T) {
+	scope := NewOuterScope("test", emptyScope)
+	extension := &Method{
+		DeclarationName: "shout",
+		ReturnType:      Builtins.String,
+		Receiver:        Builtins.String,
+	}
+	scope.Insert(extension)
+	point := NewReferencePoint("shout")
+	if found, ok := LookupExtension(scope, Builtins.String, point); !ok || found != extension {
+		testing.Error("extension of String was not found")
+	}
+	if _, ok := LookupExtension(scope, Builtins.Number, point); ok {
+		testing.Error("extension of String was found for Number")
+	}
+}
+
+func TestLookupExtension_ComparesQualifiedNames(testing *testing.T) {
+	scope := NewOuterScope("test", emptyScope)
+	extended := &Class{DeclarationName: "List", QualifiedName: "foo.List"}
+	unrelated := &Class{DeclarationName: "List", QualifiedName: "bar.List"}
+	instance := &Class{DeclarationName: "List", QualifiedName: "foo.List"}
+	extension := &Method{
+		DeclarationName: "first",
+		ReturnType:      Builtins.Any,
+		Receiver:        extended,
+	}
+	scope.Insert(extension)
+	point := NewReferencePoint("first")
+	if found, ok := LookupExtension(scope, instance, point); !ok || found != extension {
+		testing.Error("extension of foo.List was not found for foo.List")
+	}
+	if _, ok := LookupExtension(scope, unrelated, point); ok {
+		testing.Error("extension of foo.List was found for bar.List")
+	}
+}
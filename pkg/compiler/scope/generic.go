@@ -0,0 +1,178 @@
+package scope
+
+import "github.com/strict-lang/sdk/pkg/compiler/typing"
+
+// NewTypeParameter creates the class of a type parameter. Values of the
+// parameter can only be used like values of its bound. Parameters without
+// a bound are bounded by Any.
+func NewTypeParameter(name string, bound *Class) *Class {
+	parameter := &Class{
+		DeclarationName: name,
+		QualifiedName:   name,
+		Scope:           Builtins.Any.Scope,
+		ActualClass:     &typing.ParameterType{Name: name},
+	}
+	if bound != nil {
+		parameter.Scope = bound.Scope
+		parameter.ActualClass = &typing.ParameterType{
+			Name:  name,
+			Bound: actualClassOf(bound),
+		}
+	}
+	return parameter
+}
+
+// IsTypeParameter returns true if the class is the parameter of a generic
+// class, that is replaced once the class is instantiated.
+func IsTypeParameter(class *Class) bool {
+	_, ok := class.ActualClass.(*typing.ParameterType)
+	return ok
+}
+
+// Instantiate creates an instance of the generic class. The members of the
+// instance are the ones of the generic class, with every type parameter being
+// substituted by the argument at the same index. Members are substituted when
+// they are looked up, so generic classes may be instantiated before their
+// members are entered.
+func Instantiate(generic *Class, arguments []*Class) *Class {
+	instance := &Class{
+		DeclarationName: generic.DeclarationName,
+		QualifiedName:   generic.QualifiedName,
+		Arguments:       arguments,
+		ActualClass: &typing.GenericType{
+			Child:     actualClassOf(generic),
+			Arguments: actualClassesOf(arguments),
+		},
+	}
+	instance.Scope = newInstanceScope(generic, instance)
+	return instance
+}
+
+func actualClassesOf(classes []*Class) []typing.Type {
+	actualClasses := make([]typing.Type, len(classes))
+	for index, class := range classes {
+		actualClasses[index] = actualClassOf(class)
+	}
+	return actualClasses
+}
+
+// GenericOf returns the generic class, that the class is an instance of.
+// Instances of builtin generic classes have no generic class.
+func GenericOf(class *Class) (*Class, bool) {
+	if instanceScope, ok := class.Scope.(*instanceScope); ok {
+		return instanceScope.generic, true
+	}
+	return nil, false
+}
+
+// Substitution maps the type parameters of a generic class to the arguments
+// of one of its instances.
+type Substitution map[*Class]*Class
+
+// NewSubstitution creates the substitution of the instance. The parameters
+// are read from the generic class and are thus only known, once the generic
+// class has been entered.
+func NewSubstitution(generic *Class, instance *Class) Substitution {
+	substitution := Substitution{}
+	for index, parameter := range generic.Parameters {
+		if index < len(instance.Arguments) {
+			substitution[parameter] = instance.Arguments[index]
+		}
+	}
+	return substitution
+}
+
+// Apply replaces the type parameters inside of the class. Instances of
+// generic classes, whose arguments contain parameters, are instantiated
+// again with the replaced arguments.
+func (substitution Substitution) Apply(class *Class) *Class {
+	if class == nil {
+		return nil
+	}
+	if replacement, ok := substitution[class]; ok {
+		return replacement
+	}
+	if !IsGenericInstance(class) {
+		return class
+	}
+	arguments, changed := substitution.applyToArguments(class.Arguments)
+	if !changed {
+		return class
+	}
+	switch {
+	case IsListClass(class):
+		return NewListClass(arguments[0])
+	case IsMapClass(class):
+		return NewMapClass(arguments[0], arguments[1])
+	case IsResultClass(class):
+		return NewResultClass(arguments[0])
+	}
+	if generic, ok := GenericOf(class); ok {
+		return Instantiate(generic, arguments)
+	}
+	return class
+}
+
+func (substitution Substitution) applyToArguments(
+	arguments []*Class) (applied []*Class, changed bool) {
+
+	applied = make([]*Class, len(arguments))
+	for index, argument := range arguments {
+		applied[index] = substitution.Apply(argument)
+		changed = changed || applied[index] != argument
+	}
+	return applied, changed
+}
+
+func (substitution Substitution) applyToMethod(method *Method) *Method {
+	substituted := &Method{
+		DeclarationName:   method.DeclarationName,
+		declarationOffset: method.declarationOffset,
+		ReturnType:        substitution.Apply(method.ReturnType),
+		Receiver:          method.Receiver,
+		EnclosingClass:    method.EnclosingClass,
+		Abstract:          method.Abstract,
+		Origin:            method,
+	}
+	for _, parameter := range method.Parameters {
+		substituted.Parameters = append(substituted.Parameters,
+			substitution.applyToField(parameter))
+	}
+	return substituted
+}
+
+func (substitution Substitution) applyToField(field *Field) *Field {
+	if field == nil {
+		return nil
+	}
+	substituted := *field
+	substituted.Class = substitution.Apply(field.Class)
+	return &substituted
+}
+
+// FindUnsatisfiedBound returns the index of the first type parameter of the
+// generic class, whose bound is not implemented by the argument at the same
+// index.
+func FindUnsatisfiedBound(generic *Class, arguments []*Class) (int, bool) {
+	for index, parameter := range generic.Parameters {
+		if index >= len(arguments) {
+			break
+		}
+		bound, ok := BoundOf(parameter)
+		if !ok || arguments[index] == Builtins.Any {
+			continue
+		}
+		if !actualClassOf(arguments[index]).Is(bound) {
+			return index, true
+		}
+	}
+	return 0, false
+}
+
+// BoundOf returns the trait, that bounds the type parameter.
+func BoundOf(parameter *Class) (typing.Type, bool) {
+	if actual, ok := parameter.ActualClass.(*typing.ParameterType); ok {
+		return actual.Bound, actual.Bound != nil
+	}
+	return nil, false
+}
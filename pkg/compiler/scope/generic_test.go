@@ -0,0 +1,57 @@
+package scope
+
+import "testing"
+
+func createGenericBox() *Class {
+	box := &Class{DeclarationName: "Box", QualifiedName: "Box"}
+	box.Scope = NewOuterScope("Box", NewEmptyScope("Empty"))
+	element := NewTypeParameter("T", nil)
+	box.Parameters = []*Class{element}
+	box.Scope.Insert(&Method{
+		DeclarationName: "Get",
+		ReturnType:      element,
+		EnclosingClass:  box,
+	})
+	box.Scope.Insert(&Method{
+		DeclarationName: "All",
+		ReturnType:      NewListClass(element),
+		EnclosingClass:  box,
+		Parameters: []*Field{
+			{DeclarationName: "limit", Class: element},
+		},
+	})
+	return box
+}
+
+func lookupMethod(testing *testing.T, class *Class, name string) *Method {
+	entries := class.Scope.Lookup(NewReferencePoint(name))
+	if entries.IsEmpty() {
+		testing.Fatalf("method %s not found", name)
+	}
+	method, _ := AsMethodSymbol(entries.First().Symbol)
+	return method
+}
+
+func TestInstantiate(testing *testing.T) {
+	box := createGenericBox()
+	instance := Instantiate(box, []*Class{Builtins.Number})
+	if get := lookupMethod(testing, instance, "Get"); get.ReturnType != Builtins.Number {
+		testing.Errorf("expected Get to return Number, got %s", get.ReturnType)
+	}
+	all := lookupMethod(testing, instance, "All")
+	if !IsListClass(all.ReturnType) || all.ReturnType.Arguments[0] != Builtins.Number {
+		testing.Errorf("expected All to return a list of Number, got %s", all.ReturnType)
+	}
+	if all.Parameters[0].Class != Builtins.Number {
+		testing.Errorf("expected the parameter to be substituted")
+	}
+	if all != lookupMethod(testing, instance, "All") {
+		testing.Errorf("expected substituted methods to be cached")
+	}
+	if !IsAssignable(instance, Instantiate(box, []*Class{Builtins.Number})) {
+		testing.Errorf("expected instances with the same arguments to be assignable")
+	}
+	if IsAssignable(instance, Instantiate(box, []*Class{Builtins.String})) {
+		testing.Errorf("expected instances with different arguments to differ")
+	}
+}
@@ -39,6 +39,16 @@ func (creation *importScopeCreation) insert(symbol Symbol) {
 func (creation *importScopeCreation) insertNamespace(namespace *Namespace) {
 	creation.scope.Insert(namespace)
 	creation.findAndInsertTopClass(namespace)
+	creation.insertExtensions(namespace)
+}
+
+// insertExtensions makes the extension methods of an imported namespace
+// visible. They are later found by the name resolution, when a member can't
+// be found in the scope of the extended class.
+func (creation *importScopeCreation) insertExtensions(namespace *Namespace) {
+	for _, entry := range namespace.Scope.Search(filterForExtension) {
+		creation.scope.Insert(entry.Symbol)
+	}
 }
 
 func (creation *importScopeCreation) findAndInsertTopClass(namespace *Namespace) {
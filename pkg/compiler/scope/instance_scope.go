@@ -0,0 +1,103 @@
+package scope
+
+// instanceScope is the scope of an instance of a generic class. It delegates
+// to the scope of the generic class and substitutes the type parameters in
+// the signatures of the members, that are declared by the generic class.
+// Substituted members are cached, so that every lookup of a member returns
+// the same symbol. They are only cached once the parameters of the generic
+// class are known.
+type instanceScope struct {
+	generic     *Class
+	instance    *Class
+	substituted map[Symbol]Symbol
+}
+
+func newInstanceScope(generic *Class, instance *Class) *instanceScope {
+	return &instanceScope{
+		generic:     generic,
+		instance:    instance,
+		substituted: map[Symbol]Symbol{},
+	}
+}
+
+func (scope *instanceScope) genericScope() MutableScope {
+	if scope.generic.Scope == nil {
+		return &EmptyScope{id: scope.Id()}
+	}
+	return scope.generic.Scope
+}
+
+func (scope *instanceScope) Id() Id {
+	return Id(scope.instance.ActualClass.String())
+}
+
+func (scope *instanceScope) Lookup(point ReferencePoint) EntrySet {
+	return scope.substituteEntries(scope.genericScope().Lookup(point))
+}
+
+func (scope *instanceScope) Search(filter symbolFilter) (result EntrySet) {
+	entries := scope.substituteEntries(scope.genericScope().Search(acceptAll))
+	for _, entry := range entries {
+		if filter(entry.Symbol) {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+func acceptAll(Symbol) bool {
+	return true
+}
+
+func (scope *instanceScope) Contains(point ReferencePoint) bool {
+	return scope.genericScope().Contains(point)
+}
+
+// Insert inserts the symbol into the scope of the generic class, since
+// every instance shares the members of the generic class.
+func (scope *instanceScope) Insert(symbol Symbol) {
+	scope.genericScope().Insert(symbol)
+}
+
+func (scope *instanceScope) LookupOrInsert(
+	point ReferencePoint, factory symbolFactory) EntrySet {
+
+	return scope.substituteEntries(scope.genericScope().LookupOrInsert(point, factory))
+}
+
+func (scope *instanceScope) substituteEntries(entries EntrySet) EntrySet {
+	substituted := make(EntrySet, len(entries))
+	for index, entry := range entries {
+		entry.Symbol = scope.substitute(entry.Symbol)
+		substituted[index] = entry
+	}
+	return substituted
+}
+
+// substitute substitutes the type parameters in the signature of members
+// of the generic class. Other symbols are not substituted.
+func (scope *instanceScope) substitute(symbol Symbol) Symbol {
+	if substituted, ok := scope.substituted[symbol]; ok {
+		return substituted
+	}
+	substitution := NewSubstitution(scope.generic, scope.instance)
+	var substituted Symbol
+	switch symbol := symbol.(type) {
+	case *Method:
+		if symbol.EnclosingClass != scope.generic {
+			return symbol
+		}
+		substituted = substitution.applyToMethod(symbol)
+	case *Field:
+		if symbol.EnclosingClass != scope.generic {
+			return symbol
+		}
+		substituted = substitution.applyToField(symbol)
+	default:
+		return symbol
+	}
+	if len(scope.generic.Parameters) != 0 {
+		scope.substituted[symbol] = substituted
+	}
+	return substituted
+}
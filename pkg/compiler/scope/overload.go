@@ -0,0 +1,178 @@
+package scope
+
+import "strings"
+
+// Argument describes an argument of a call, that is used to select one of
+// the overloads of a method. The class is nil, if it could not be resolved.
+type Argument struct {
+	Label string
+	Class *Class
+}
+
+// HasSameSignature returns true if both methods can not be distinguished by
+// the labels and classes of their parameters. Methods with the same
+// signature can not overload each other.
+func HasSameSignature(left *Method, right *Method) bool {
+	if len(left.Parameters) != len(right.Parameters) {
+		return false
+	}
+	for index, parameter := range left.Parameters {
+		other := right.Parameters[index]
+		if parameter == nil || other == nil {
+			continue
+		}
+		if parameter.ArgumentLabel() != other.ArgumentLabel() {
+			return false
+		}
+		if !isSameParameterClass(parameter.Class, other.Class) {
+			return false
+		}
+	}
+	return true
+}
+
+func isSameParameterClass(left *Class, right *Class) bool {
+	if left == nil || right == nil {
+		return true
+	}
+	return IsAssignable(left, right) && IsAssignable(right, left)
+}
+
+// ListMethods returns the methods of the entries. Entries of other symbols
+// are skipped.
+func ListMethods(entries EntrySet) (methods []*Method) {
+	for _, entry := range entries {
+		if method, ok := AsMethodSymbol(entry.Symbol); ok {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
+
+// SelectOverloads returns the overloads, that accept the arguments. If more
+// than one overload accepts the arguments, the ones whose parameters have
+// exactly the classes of the arguments are preferred. The result contains
+// more than one method, if the call is ambiguous.
+func SelectOverloads(overloads []*Method, arguments []Argument) []*Method {
+	var applicable, exact []*Method
+	for _, overload := range overloads {
+		if parameters, ok := matchArguments(overload, arguments); ok {
+			applicable = append(applicable, overload)
+			if hasExactClasses(parameters, arguments) {
+				exact = append(exact, overload)
+			}
+		}
+	}
+	if len(applicable) > 1 && len(exact) != 0 {
+		return exact
+	}
+	return applicable
+}
+
+// matchArguments returns the parameters that the arguments are passed to.
+// Labeled arguments are passed to the parameter with the same label and
+// unlabeled ones to the parameter at their position. The arguments are
+// rejected, if more than one of them is passed to the same parameter.
+func matchArguments(method *Method, arguments []Argument) ([]*Field, bool) {
+	if len(method.Parameters) != len(arguments) {
+		return nil, false
+	}
+	parameters := make([]*Field, len(arguments))
+	passed := map[*Field]bool{}
+	for index, argument := range arguments {
+		parameter, ok := selectParameter(method, argument, index)
+		if !ok || !acceptsArgument(parameter, argument) {
+			return nil, false
+		}
+		if parameter != nil {
+			if passed[parameter] {
+				return nil, false
+			}
+			passed[parameter] = true
+		}
+		parameters[index] = parameter
+	}
+	return parameters, true
+}
+
+func selectParameter(method *Method, argument Argument, index int) (*Field, bool) {
+	if argument.Label == "" {
+		return method.Parameters[index], true
+	}
+	for _, parameter := range method.Parameters {
+		if parameter != nil && parameter.ArgumentLabel() == argument.Label {
+			return parameter, true
+		}
+	}
+	return nil, false
+}
+
+func acceptsArgument(parameter *Field, argument Argument) bool {
+	if parameter == nil || parameter.Class == nil || argument.Class == nil {
+		return true
+	}
+	return IsAssignable(argument.Class, parameter.Class)
+}
+
+func hasExactClasses(parameters []*Field, arguments []Argument) bool {
+	for index, parameter := range parameters {
+		if parameter == nil || parameter.Class != arguments[index].Class {
+			return false
+		}
+	}
+	return true
+}
+
+// IsOverloaded returns true if the class, that encloses the method, declares
+// other methods with the same name.
+func IsOverloaded(method *Method) bool {
+	class := method.EnclosingClass
+	if class == nil || class.Scope == nil {
+		return false
+	}
+	count := 0
+	point := NewReferencePoint(method.Name())
+	for _, overload := range ListMethods(class.Scope.Lookup(point)) {
+		if overload.EnclosingClass == class && !overload.IsExtension() {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// MangleName creates a name for the method, that is unique among the
+// overloads of the method. Methods that are not overloaded keep their name.
+// The mangled name is derived from the labels and classes of the parameters
+// and does thus not depend on the order in which the overloads are declared.
+// Methods of generic instances are mangled like the method they have been
+// substituted from.
+func MangleName(method *Method) string {
+	if method.Origin != nil {
+		method = method.Origin
+	}
+	if !IsOverloaded(method) {
+		return method.Name()
+	}
+	var builder strings.Builder
+	builder.WriteString(method.Name())
+	for _, parameter := range method.Parameters {
+		builder.WriteString("_")
+		if parameter == nil {
+			continue
+		}
+		builder.WriteString(parameter.ArgumentLabel())
+		if parameter.Class != nil {
+			builder.WriteString("_")
+			builder.WriteString(mangleClassName(parameter.Class))
+		}
+	}
+	return builder.String()
+}
+
+func mangleClassName(class *Class) string {
+	name := class.DeclarationName
+	for _, argument := range class.Arguments {
+		name += "_" + mangleClassName(argument)
+	}
+	return strings.ReplaceAll(name, ".", "_")
+}
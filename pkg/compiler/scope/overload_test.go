@@ -0,0 +1,93 @@
+package scope
+
+import "testing"
+
+func createOverloadedMethods() (*Method, *Method) {
+	class := &Class{DeclarationName: "Test", QualifiedName: "Test"}
+	class.Scope = NewOuterScope("Test", NewEmptyScope("Empty"))
+	byNumber := &Method{
+		DeclarationName: "Add",
+		EnclosingClass:  class,
+		Parameters: []*Field{
+			{DeclarationName: "value", Class: Builtins.Number},
+		},
+	}
+	byFloat := &Method{
+		DeclarationName: "Add",
+		EnclosingClass:  class,
+		Parameters: []*Field{
+			{DeclarationName: "fraction", Label: "value", Class: Builtins.Float},
+		},
+	}
+	class.Scope.Insert(byNumber)
+	class.Scope.Insert(byFloat)
+	return byNumber, byFloat
+}
+
+func TestSelectOverloads(testing *testing.T) {
+	byNumber, byFloat := createOverloadedMethods()
+	overloads := []*Method{byNumber, byFloat}
+	entries := []struct {
+		argument Argument
+		expected []*Method
+	}{
+		{Argument{Class: Builtins.Number}, []*Method{byNumber}},
+		{Argument{Label: "value", Class: Builtins.Float}, []*Method{byFloat}},
+		{Argument{Label: "fraction", Class: Builtins.Float}, nil},
+		{Argument{Class: Builtins.String}, nil},
+		{Argument{Label: "value"}, []*Method{byNumber, byFloat}},
+	}
+	for _, entry := range entries {
+		selected := SelectOverloads(overloads, []Argument{entry.argument})
+		if len(selected) != len(entry.expected) {
+			testing.Errorf("expected %d overloads for %v, got %d",
+				len(entry.expected), entry.argument, len(selected))
+			continue
+		}
+		for index, method := range selected {
+			if method != entry.expected[index] {
+				testing.Errorf("selected unexpected overload for %v", entry.argument)
+			}
+		}
+	}
+}
+
+func TestSelectOverloads_RejectsDuplicateLabels(testing *testing.T) {
+	method := &Method{
+		DeclarationName: "Move",
+		Parameters: []*Field{
+			{DeclarationName: "x", Class: Builtins.Number},
+			{DeclarationName: "y", Class: Builtins.Number},
+		},
+	}
+	calls := [][]Argument{
+		{{Label: "x", Class: Builtins.Number}, {Label: "x", Class: Builtins.Number}},
+		{{Class: Builtins.Number}, {Label: "x", Class: Builtins.Number}},
+	}
+	for _, arguments := range calls {
+		if selected := SelectOverloads([]*Method{method}, arguments); len(selected) != 0 {
+			testing.Errorf("expected the arguments %v to be rejected", arguments)
+		}
+	}
+	arguments := []Argument{
+		{Label: "y", Class: Builtins.Number},
+		{Label: "x", Class: Builtins.Number},
+	}
+	if selected := SelectOverloads([]*Method{method}, arguments); len(selected) != 1 {
+		testing.Errorf("expected the arguments %v to be accepted", arguments)
+	}
+}
+
+func TestMangleName(testing *testing.T) {
+	byNumber, byFloat := createOverloadedMethods()
+	if name := MangleName(byNumber); name != "Add_value_Number" {
+		testing.Errorf("unexpected mangled name %s", name)
+	}
+	if name := MangleName(byFloat); name != "Add_value_Float" {
+		testing.Errorf("unexpected mangled name %s", name)
+	}
+	single := &Method{DeclarationName: "Add"}
+	if name := MangleName(single); name != "Add" {
+		testing.Errorf("expected methods without overloads to keep their name")
+	}
+}
@@ -0,0 +1,72 @@
+package scope
+
+import "github.com/strict-lang/sdk/pkg/compiler/typing"
+
+// ResultClassName is the name of the builtin class that is returned by
+// methods which can fail. A Result either holds a value or a failure with
+// a message. Failures are created by calling the builtin Error method.
+const ResultClassName = "Result"
+
+const (
+	resultIsErrorMethodName      = "IsError"
+	resultErrorMessageMethodName = "ErrorMessage"
+	resultValueMethodName        = "Value"
+	errorMethodName              = "Error"
+)
+
+func createResultType() *Class {
+	class := createPrimitiveClass(ResultClassName)
+	class.ActualClass = typing.NewEmptyClass(ResultClassName)
+	return class
+}
+
+func createResultContents(value *Class) MutableScope {
+	contents := NewOuterScope("Builtin.Result", emptyScope)
+	contents.Insert(createBuiltinMethod(resultIsErrorMethodName, Builtins.Boolean))
+	contents.Insert(createBuiltinMethod(resultErrorMessageMethodName, Builtins.String))
+	contents.Insert(createBuiltinMethod(resultValueMethodName, value))
+	return contents
+}
+
+func createErrorMethod() *Method {
+	return createBuiltinMethod(errorMethodName, Builtins.Result,
+		createBuiltinParameter("message", Builtins.String))
+}
+
+// NewResultClass creates the class of a Result, that holds a value of the
+// passed class if it does not fail.
+func NewResultClass(value *Class) *Class {
+	return &Class{
+		DeclarationName: ResultClassName,
+		QualifiedName:   ResultClassName,
+		Scope:           createResultContents(value),
+		Arguments:       []*Class{value},
+		ActualClass: &typing.GenericType{
+			Child:     Builtins.Result.ActualClass,
+			Arguments: []typing.Type{actualClassOf(value)},
+		},
+	}
+}
+
+func actualClassOf(class *Class) typing.Type {
+	if class.ActualClass != nil {
+		return class.ActualClass
+	}
+	return typing.NewEmptyClass(class.DeclarationName)
+}
+
+// IsResultClass returns true if the class is the builtin Result class or one
+// of its instantiations.
+func IsResultClass(class *Class) bool {
+	return class == Builtins.Result ||
+		(class.DeclarationName == ResultClassName && len(class.Arguments) == 1)
+}
+
+// ResultValueClass returns the class of the value that is held by a Result.
+// If the Result is not instantiated with a value class, Any is returned.
+func ResultValueClass(class *Class) *Class {
+	if IsResultClass(class) && len(class.Arguments) == 1 {
+		return class.Arguments[0]
+	}
+	return Builtins.Any
+}
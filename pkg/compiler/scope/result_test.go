@@ -0,0 +1,20 @@
+package scope
+
+import "testing"
+
+func TestNewResultClass(testing *testing.T) {
+	result := NewResultClass(Builtins.Number)
+	if !IsResultClass(result) {
+		testing.Error("instantiated Result is not a Result class")
+	}
+	if value := ResultValueClass(result); value != Builtins.Number {
+		testing.Errorf("expected value class Number but got %s", value)
+	}
+	entries := result.Scope.Lookup(NewReferencePoint("Value"))
+	if method, ok := entries.First().Symbol.(*Method); !ok || method.ReturnType != Builtins.Number {
+		testing.Error("Value method does not return the class of the value")
+	}
+	if IsResultClass(Builtins.Number) {
+		testing.Error("Number is treated as a Result class")
+	}
+}
@@ -18,6 +18,25 @@ type Method struct {
 	ReturnType        *Class
 	// Parameters are lazily added
 	Parameters []*Field
+	// Receiver is the class that is extended by an extension method.
+	// It is nil for methods that are declared inside of their class.
+	Receiver *Class
+	// EnclosingClass is the class that declares the method.
+	EnclosingClass *Class
+	// Abstract methods have no body and have to be implemented by every
+	// class, that implements the trait declaring them.
+	Abstract bool
+	// Origin is the method of a generic class, that the method has been
+	// substituted from. It is nil for methods that are declared directly.
+	Origin *Method
+}
+
+// PostconditionResultName is the name of the implicit field, that holds the
+// value returned by a method, inside of the methods postconditions.
+const PostconditionResultName = "result"
+
+func (method *Method) IsExtension() bool {
+	return method.Receiver != nil
 }
 
 func (method *Method) Name() string {
@@ -38,6 +57,15 @@ type Class struct {
 	DeclarationName   string
 	QualifiedName     string
 	ActualClass       typing.Type
+	// Arguments are the classes that a generic class is instantiated with.
+	// They are empty for classes that are not generic.
+	Arguments         []*Class
+	// Parameters are the type parameters of a generic class. They are
+	// replaced by the arguments of its instances.
+	Parameters        []*Class
+	// Traits are the traits, that the class implements. For traits they are
+	// their super-traits. Traits are recorded with AddTrait.
+	Traits            []*Class
 	declarationOffset input.Offset
 }
 
@@ -65,6 +93,12 @@ type Field struct {
 	Class             *Class
 	Kind              FieldKind
 	EnclosingClass    *Class
+	// Immutable fields can not be reassigned once they are initialized.
+	// Names that are bound by a let binding are immutable.
+	Immutable bool
+	// Label is the name that arguments use to refer to a parameter. It is
+	// empty if the parameter is referred to by its declaration name.
+	Label string
 }
 
 type FieldKind int
@@ -80,6 +114,15 @@ func (field *Field) Name() string {
 	return field.DeclarationName
 }
 
+// ArgumentLabel returns the label that labeled arguments have to use, to
+// be passed to the parameter.
+func (field *Field) ArgumentLabel() string {
+	if field.Label != "" {
+		return field.Label
+	}
+	return field.DeclarationName
+}
+
 func (field *Field) String() string {
 	return fmt.Sprintf("Field{Name: %s, Type: %s}", field.DeclarationName, field.Class)
 }
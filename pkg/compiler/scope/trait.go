@@ -0,0 +1,98 @@
+package scope
+
+import (
+	"sort"
+
+	"github.com/strict-lang/sdk/pkg/compiler/typing"
+)
+
+// ListAbstractMethods returns the abstract methods, that are declared by the
+// class and by its super-traits. Classes that implement the class have to
+// provide them. The scope of the class is searched including its parents,
+// since classes that are entered from api descriptors share the scope of
+// their namespace. Methods of super-traits are omitted, if the class itself
+// declares a method with the same name and signature. The methods are
+// sorted by their name.
+func ListAbstractMethods(class *Class) []*Method {
+	collection := &abstractMethodCollection{visited: map[*Class]bool{}}
+	collection.collect(class)
+	methods := collection.methods
+	sort.SliceStable(methods, func(left, right int) bool {
+		return methods[left].Name() < methods[right].Name()
+	})
+	return methods
+}
+
+// abstractMethodCollection collects the abstract methods of a trait hierarchy.
+// It remembers the visited traits, so that it terminates for cyclic ones.
+type abstractMethodCollection struct {
+	methods []*Method
+	visited map[*Class]bool
+}
+
+func (collection *abstractMethodCollection) collect(class *Class) {
+	if collection.visited[class] || class.Scope == nil {
+		return
+	}
+	collection.visited[class] = true
+	for _, entry := range class.Scope.Search(filterForAbstractMethod(class)) {
+		method := entry.Symbol.(*Method)
+		if !collection.containsMethod(method) {
+			collection.methods = append(collection.methods, method)
+		}
+	}
+	for _, trait := range class.Traits {
+		collection.collect(trait)
+	}
+}
+
+func (collection *abstractMethodCollection) containsMethod(method *Method) bool {
+	for _, collected := range collection.methods {
+		if collected.Name() == method.Name() && HasSameSignature(collected, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func filterForAbstractMethod(class *Class) symbolFilter {
+	return func(symbol Symbol) bool {
+		method, ok := symbol.(*Method)
+		return ok && method.Abstract && method.EnclosingClass == class
+	}
+}
+
+// AddTrait records that the class implements the trait. Afterwards values of
+// the class are also values of the trait and of its super-traits. Traits of
+// the same namespace may not have been entered yet, their actual class is
+// then created here and kept once they are entered.
+func AddTrait(class *Class, trait *Class) {
+	if ImplementsTrait(class, trait) {
+		return
+	}
+	class.Traits = append(class.Traits, trait)
+	concrete, ok := class.ActualClass.(*typing.ConcreteType)
+	if ok {
+		concrete.AddTraits(EnsureActualClass(trait))
+	}
+}
+
+// ImplementsTrait returns true if the trait has been recorded for the class.
+// Super-traits of the recorded traits are not taken into account.
+func ImplementsTrait(class *Class, trait *Class) bool {
+	for _, implemented := range class.Traits {
+		if implemented == trait {
+			return true
+		}
+	}
+	return false
+}
+
+// EnsureActualClass returns the actual class of the class and creates an
+// empty one, if it has not been created yet.
+func EnsureActualClass(class *Class) typing.Type {
+	if class.ActualClass == nil {
+		class.ActualClass = typing.NewEmptyClass(class.DeclarationName)
+	}
+	return class.ActualClass
+}
@@ -0,0 +1,63 @@
+package scope
+
+import (
+	"testing"
+
+	"github.com/strict-lang/sdk/pkg/compiler/typing"
+)
+
+func createTrait(name string, methods ...string) *Class {
+	trait := &Class{
+		DeclarationName: name,
+		QualifiedName:   name,
+		ActualClass:     typing.NewEmptyClass(name),
+	}
+	trait.Scope = NewOuterScope(Id(name), NewEmptyScope("Empty"))
+	for _, method := range methods {
+		trait.Scope.Insert(&Method{
+			DeclarationName: method,
+			ReturnType:      Builtins.Void,
+			EnclosingClass:  trait,
+			Abstract:        true,
+		})
+	}
+	return trait
+}
+
+func TestListAbstractMethods_IncludesSuperTraits(testing *testing.T) {
+	named := createTrait("Named", "Name")
+	runnable := createTrait("Runnable", "Run", "Name")
+	service := createTrait("Service", "Stop")
+	AddTrait(runnable, named)
+	AddTrait(service, runnable)
+	AddTrait(named, service)
+
+	expected := []string{"Name", "Run", "Stop"}
+	methods := ListAbstractMethods(service)
+	if len(methods) != len(expected) {
+		testing.Fatalf("expected %d abstract methods but got %d",
+			len(expected), len(methods))
+	}
+	for index, method := range methods {
+		if method.Name() != expected[index] {
+			testing.Errorf("expected method %s but got %s",
+				expected[index], method.Name())
+		}
+	}
+	if methods[0].EnclosingClass != runnable {
+		testing.Errorf("expected the method of the nearest trait to be listed")
+	}
+}
+
+func TestAddTrait_RecordsTraitOnce(testing *testing.T) {
+	named := createTrait("Named")
+	class := createTrait("Test")
+	AddTrait(class, named)
+	AddTrait(class, named)
+	if len(class.Traits) != 1 || !ImplementsTrait(class, named) {
+		testing.Errorf("expected the trait to be recorded once: %v", class.Traits)
+	}
+	if !IsAssignable(class, named) {
+		testing.Errorf("expected the class to be assignable to its trait")
+	}
+}
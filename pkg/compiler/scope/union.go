@@ -0,0 +1,57 @@
+package scope
+
+import "github.com/strict-lang/sdk/pkg/compiler/typing"
+
+// NewUnionClass creates the class of a union without any cases. Cases are
+// added to the union using AddUnionCase.
+func NewUnionClass(name string, parent Scope) *Class {
+	return &Class{
+		DeclarationName: name,
+		QualifiedName:   name,
+		Scope:           NewOuterScope(Id(name), parent),
+		ActualClass:     &typing.UnionType{Name: name},
+	}
+}
+
+// AddUnionCase adds a case to the union and returns its constructor. The
+// constructor is a method that takes the payload of the case and returns
+// a value of the union.
+func AddUnionCase(union *Class, name string, payload []*Field) *Method {
+	unionType := union.ActualClass.(*typing.UnionType)
+	unionType.Cases = append(unionType.Cases, typing.NewEmptyClass(name))
+	constructor := &Method{
+		DeclarationName: name,
+		ReturnType:      union,
+		Parameters:      payload,
+	}
+	union.Scope.Insert(constructor)
+	return constructor
+}
+
+func IsUnionClass(class *Class) bool {
+	_, isUnion := class.ActualClass.(*typing.UnionType)
+	return isUnion
+}
+
+// LookupUnionCase returns the constructor of the unions case with the
+// passed name. Its parameters are the payload of the case.
+func LookupUnionCase(union *Class, name string) (*Method, bool) {
+	entries := union.Scope.Lookup(NewReferencePoint(name))
+	for _, entry := range entries {
+		if method, ok := entry.Symbol.(*Method); ok && method.ReturnType == union {
+			return method, true
+		}
+	}
+	return nil, false
+}
+
+// UnionCaseNames returns the names of the unions cases in the order of
+// their declaration.
+func UnionCaseNames(union *Class) (names []string) {
+	if unionType, ok := union.ActualClass.(*typing.UnionType); ok {
+		for _, unionCase := range unionType.Cases {
+			names = append(names, unionCase.String())
+		}
+	}
+	return names
+}
@@ -0,0 +1,60 @@
+package scope
+
+import (
+	"github.com/strict-lang/sdk/pkg/compiler/typing"
+	"testing"
+)
+
+func createTestUnion() *Class {
+	union := NewUnionClass("Shape", emptyScope)
+	AddUnionCase(union, "Circle", []*Field{
+		{DeclarationName: "radius", Class: Builtins.Number, Kind: ParameterField},
+	})
+	AddUnionCase(union, "Empty", nil)
+	return union
+}
+
+func TestLookupUnionCase(testing *testing.T) {
+	union := createTestUnion()
+	circle, ok := LookupUnionCase(union, "Circle")
+	if !ok {
+		testing.Fatal("could not find the case Circle")
+	}
+	if circle.ReturnType != union || len(circle.Parameters) != 1 {
+		testing.Error("constructor of the case does not create the union")
+	}
+	if _, ok := LookupUnionCase(union, "Square"); ok {
+		testing.Error("found case that was not added to the union")
+	}
+}
+
+func TestUnionCaseNames(testing *testing.T) {
+	names := UnionCaseNames(createTestUnion())
+	if len(names) != 2 || names[0] != "Circle" || names[1] != "Empty" {
+		testing.Errorf("unexpected case names %v", names)
+	}
+}
+
+func TestUnionType_Is(testing *testing.T) {
+	union := createTestUnion().ActualClass
+	if !typing.NewEmptyClass("Circle").Is(union) {
+		testing.Error("case is not a member of its union")
+	}
+	if typing.NewEmptyClass("Square").Is(union) {
+		testing.Error("foreign class is a member of the union")
+	}
+	wider := &typing.UnionType{
+		Name: "Figure",
+		Cases: []typing.Type{
+			typing.NewEmptyClass("Circle"),
+			typing.NewEmptyClass("Empty"),
+			typing.NewEmptyClass("Square"),
+		},
+	}
+	if !union.Is(wider) {
+		testing.Error("union is not a member of a union containing all its cases")
+	}
+	if wider.Is(union) {
+		testing.Error("union is a member of a union missing some of its cases")
+	}
+}
@@ -1,8 +1,26 @@
 package typing
 
+import "sync"
+
 type ConcreteType struct {
-	Name   string
+	Name string
+	// Traits are the traits, that the type implements. They have to be added
+	// with AddTraits, which invalidates the cached supertypes of the type and
+	// of its subtypes. Traits that are appended directly are only taken into
+	// account by types, whose supertypes have not been cached yet.
 	Traits []Type
+	// subtypes are the types, that directly implement the type as a trait.
+	subtypes []*ConcreteType
+	// top is true for the type, that every other type is a subtype of.
+	top        bool
+	mutex      sync.RWMutex
+	supertypes supertypeCache
+}
+
+// NewTopType creates the type, that every other type is a subtype of. It is
+// only created once by the builtins of the scope package.
+func NewTopType(name string) Type {
+	return &ConcreteType{Name: name, top: true}
 }
 
 func (concrete *ConcreteType) Concrete() Type {
@@ -14,29 +32,55 @@ func (concrete *ConcreteType) String() string {
 }
 
 func (concrete *ConcreteType) Is(target Type) bool {
-	if targetConcrete, ok := target.(*ConcreteType); ok {
-		return concrete.matches(targetConcrete)
+	return IsSubtype(concrete, target)
+}
+
+// AddTraits records that the type implements the traits. Values of the type
+// are then also values of the traits and of their super-traits. The cached
+// supertypes of the type and of every type, that implements it, are
+// invalidated. Traits can be added while other goroutines check subtypes.
+func (concrete *ConcreteType) AddTraits(traits ...Type) {
+	concrete.mutex.Lock()
+	concrete.Traits = append(concrete.Traits, traits...)
+	concrete.mutex.Unlock()
+	for _, trait := range traits {
+		if implemented, ok := trait.Concrete().(*ConcreteType); ok {
+			implemented.addSubtype(concrete)
+		}
 	}
-	return false
+	concrete.invalidateSupertypes(map[*ConcreteType]bool{})
 }
 
-func (concrete *ConcreteType) matches(target *ConcreteType) bool {
-	if concrete.matchesExact(target) {
-		return true
+func (concrete *ConcreteType) addSubtype(subtype *ConcreteType) {
+	concrete.mutex.Lock()
+	defer concrete.mutex.Unlock()
+	concrete.subtypes = append(concrete.subtypes, subtype)
+}
+
+// invalidateSupertypes clears the cached supertypes of the type and of its
+// transitive subtypes. Types that have already been visited are skipped, so
+// that cyclic hierarchies are only traversed once.
+func (concrete *ConcreteType) invalidateSupertypes(visited map[*ConcreteType]bool) {
+	if visited[concrete] {
+		return
 	}
-	// FIXME: Use hashtable instead for performance improvement.
-	for _, targetTrait := range target.Traits {
-		for _, trait := range concrete.Traits {
-			if trait.Is(targetTrait) {
-				return true
-			}
-		}
+	visited[concrete] = true
+	concrete.supertypes.invalidate()
+	for _, subtype := range concrete.listSubtypes() {
+		subtype.invalidateSupertypes(visited)
 	}
-	return false
 }
 
-func (concrete *ConcreteType) matchesExact(target *ConcreteType) bool {
-	return concrete.Name == target.Name
+func (concrete *ConcreteType) listTraits() []Type {
+	concrete.mutex.RLock()
+	defer concrete.mutex.RUnlock()
+	return concrete.Traits
+}
+
+func (concrete *ConcreteType) listSubtypes() []*ConcreteType {
+	concrete.mutex.RLock()
+	defer concrete.mutex.RUnlock()
+	return concrete.subtypes
 }
 
 func (concrete *ConcreteType) Accept(visitor Visitor) {
@@ -11,30 +11,7 @@ type GenericType struct {
 }
 
 func (generic *GenericType) Is(target Type) bool {
-	if targetGeneric, ok := target.(*GenericType); ok {
-		return generic.matches(targetGeneric)
-	}
-	return false
-}
-
-func (generic *GenericType) matches(target *GenericType) bool {
-	return generic.Child.Is(target.Child) && generic.matchesArguments(target)
-}
-
-func (generic *GenericType) matchesArguments(target *GenericType) bool {
-	if len(generic.Arguments) != len(target.Arguments) {
-		return false
-	}
-	return generic.sharesTraits(target)
-}
-
-func (generic *GenericType) sharesTraits(target *GenericType) bool {
-	for index, argument := range target.Arguments {
-		if targetArgument := target.Arguments[index]; targetArgument != argument {
-			return false
-		}
-	}
-	return true
+	return IsSubtype(generic, target)
 }
 
 func (generic *GenericType) Accept(visitor Visitor) {
@@ -15,10 +15,7 @@ func (list *ListType) String() string {
 }
 
 func (list *ListType) Is(target Type) bool {
-	if targetList, ok := target.(*ListType); ok {
-		return list.Child.Is(targetList.Child)
-	}
-	return false
+	return IsSubtype(list, target)
 }
 
 func (list *ListType) Accept(visitor Visitor) {
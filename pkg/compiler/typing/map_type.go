@@ -0,0 +1,31 @@
+package typing
+
+import "fmt"
+
+// MapType is the type of maps, that associate keys with values.
+type MapType struct {
+	Key   Type
+	Value Type
+}
+
+func (mapType *MapType) Concrete() Type {
+	return mapType
+}
+
+func (mapType *MapType) String() string {
+	return fmt.Sprintf("Map<%s, %s>", mapType.Key, mapType.Value)
+}
+
+func (mapType *MapType) Is(target Type) bool {
+	return IsSubtype(mapType, target)
+}
+
+func (mapType *MapType) Accept(visitor Visitor) {
+	visitor.VisitMap(mapType)
+}
+
+func (mapType *MapType) AcceptRecursive(visitor Visitor) {
+	mapType.Accept(visitor)
+	mapType.Key.AcceptRecursive(visitor)
+	mapType.Value.AcceptRecursive(visitor)
+}
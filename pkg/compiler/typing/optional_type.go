@@ -15,10 +15,7 @@ func (optional *OptionalType) String() string {
 }
 
 func (optional *OptionalType) Is(target Type) bool {
-	if optionalType, ok := target.(*OptionalType); ok {
-		return optional.Child.Is(optionalType.Child)
-	}
-	return false
+	return IsSubtype(optional, target)
 }
 
 func (optional *OptionalType) Accept(visitor Visitor) {
@@ -0,0 +1,30 @@
+package typing
+
+// ParameterType is the type of a type parameter of a generic class. It is
+// replaced by the arguments of the class, when the class is instantiated.
+// Parameters may be bounded by a trait, which their arguments have to
+// implement. Values of a bounded parameter are values of the bound.
+type ParameterType struct {
+	Name  string
+	Bound Type
+}
+
+func (parameter *ParameterType) Concrete() Type {
+	return parameter
+}
+
+func (parameter *ParameterType) String() string {
+	return parameter.Name
+}
+
+func (parameter *ParameterType) Is(target Type) bool {
+	return IsSubtype(parameter, target)
+}
+
+func (parameter *ParameterType) Accept(visitor Visitor) {
+	visitor.VisitParameter(parameter)
+}
+
+func (parameter *ParameterType) AcceptRecursive(visitor Visitor) {
+	parameter.Accept(visitor)
+}
@@ -0,0 +1,212 @@
+package typing
+
+import "sync"
+
+// IsSubtype returns true if values of the first type are values of the target
+// type. Classes are subtypes of the traits they implement and traits are
+// subtypes of their super-traits, transitively. Every type is a subtype of
+// the top type and of the optional type, that wraps one of its super types. Generic
+// instances, lists and maps are subtypes of other instances of the same
+// class, if their arguments are the same types. Arguments are invariant,
+// since values of the instances can be both read and written.
+//
+// Results for concrete types are cached by the types themselves, since the
+// trait hierarchies of large packages are traversed for every comparison of
+// the type checker. The caches of a type are invalidated, when traits are
+// added to the type or to one of its supertypes.
+func IsSubtype(value Type, target Type) bool {
+	if value == target {
+		return true
+	}
+	concrete, isConcrete := value.(*ConcreteType)
+	if !isConcrete {
+		return searchSubtype(value, target)
+	}
+	result, ok, version := concrete.supertypes.lookup(target)
+	if ok {
+		return result
+	}
+	result = searchSubtype(value, target)
+	concrete.supertypes.store(target, result, version)
+	return result
+}
+
+func searchSubtype(value Type, target Type) bool {
+	search := &subtypeSearch{visited: map[typePair]bool{}}
+	return search.isSubtype(value, target)
+}
+
+type typePair struct {
+	value  Type
+	target Type
+}
+
+// subtypeSearch traverses the trait hierarchies. It remembers the visited
+// pairs, so that it terminates for cyclic hierarchies.
+type subtypeSearch struct {
+	visited map[typePair]bool
+}
+
+func (search *subtypeSearch) isSubtype(value Type, target Type) bool {
+	if value == target || isTopType(target) {
+		return true
+	}
+	pair := typePair{value: value, target: target}
+	if search.visited[pair] {
+		return false
+	}
+	search.visited[pair] = true
+	if search.isWrappedSubtype(value, target) {
+		return true
+	}
+	switch value := value.(type) {
+	case *ConcreteType:
+		return search.isConcreteSubtype(value, target)
+	case *GenericType:
+		return search.isGenericSubtype(value, target)
+	case *ListType:
+		targetList, ok := target.(*ListType)
+		return ok && search.isSameType(value.Child, targetList.Child)
+	case *MapType:
+		targetMap, ok := target.(*MapType)
+		return ok && search.isSameType(value.Key, targetMap.Key) &&
+			search.isSameType(value.Value, targetMap.Value)
+	case *OptionalType:
+		targetOptional, ok := target.(*OptionalType)
+		return ok && search.isSubtype(value.Child, targetOptional.Child)
+	case *ParameterType:
+		return search.isParameterSubtype(value, target)
+	case *UnionType:
+		return search.isUnionSubtype(value, target)
+	}
+	return false
+}
+
+func isTopType(target Type) bool {
+	concrete, ok := target.(*ConcreteType)
+	return ok && concrete.top
+}
+
+// isWrappedSubtype returns true if the target wraps a super type of the value.
+// Values that are not optional are values of optionals of their super types
+// and values, that are not unions, are values of unions that contain them.
+func (search *subtypeSearch) isWrappedSubtype(value Type, target Type) bool {
+	switch target := target.(type) {
+	case *OptionalType:
+		if _, isOptional := value.(*OptionalType); !isOptional {
+			return search.isSubtype(value, target.Child)
+		}
+	case *UnionType:
+		if _, isUnion := value.(*UnionType); !isUnion {
+			return search.isUnionMember(value, target)
+		}
+	}
+	return false
+}
+
+func (search *subtypeSearch) isUnionMember(value Type, union *UnionType) bool {
+	for _, unionCase := range union.Cases {
+		if search.isSubtype(value, unionCase) {
+			return true
+		}
+	}
+	return false
+}
+
+func (search *subtypeSearch) isConcreteSubtype(value *ConcreteType, target Type) bool {
+	if targetConcrete, ok := target.(*ConcreteType); ok && value.Name == targetConcrete.Name {
+		return true
+	}
+	for _, trait := range value.listTraits() {
+		if search.isSubtype(trait, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGenericSubtype compares generic instances by their class and arguments.
+// Instances are also subtypes of the traits, that their class implements.
+func (search *subtypeSearch) isGenericSubtype(value *GenericType, target Type) bool {
+	targetGeneric, ok := target.(*GenericType)
+	if !ok {
+		return search.isSubtype(value.Child, target)
+	}
+	if !search.isSubtype(value.Child, targetGeneric.Child) ||
+		len(value.Arguments) != len(targetGeneric.Arguments) {
+		return false
+	}
+	for index, argument := range value.Arguments {
+		if !search.isSameType(argument, targetGeneric.Arguments[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSameType returns true if both types are subtypes of each other. It is
+// used to compare invariant arguments.
+func (search *subtypeSearch) isSameType(value Type, target Type) bool {
+	return search.isSubtype(value, target) && search.isSubtype(target, value)
+}
+
+func (search *subtypeSearch) isParameterSubtype(value *ParameterType, target Type) bool {
+	if targetParameter, ok := target.(*ParameterType); ok && value.Name == targetParameter.Name {
+		return true
+	}
+	return value.Bound != nil && search.isSubtype(value.Bound, target)
+}
+
+// isUnionSubtype returns true if the target is the same union or a union,
+// that has every case of the value as its member.
+func (search *subtypeSearch) isUnionSubtype(value *UnionType, target Type) bool {
+	targetUnion, ok := target.(*UnionType)
+	if !ok {
+		return false
+	}
+	if value.Name == targetUnion.Name {
+		return true
+	}
+	for _, unionCase := range value.Cases {
+		if !search.isUnionMember(unionCase, targetUnion) {
+			return false
+		}
+	}
+	return true
+}
+
+// supertypeCache caches the results of the subtype checks of a concrete type,
+// keyed by the target of the check. Its version is incremented, whenever it
+// is invalidated. Results of checks, that started before the cache has been
+// invalidated, are then not stored.
+type supertypeCache struct {
+	entries map[Type]bool
+	version uint64
+	mutex   sync.RWMutex
+}
+
+func (cache *supertypeCache) lookup(target Type) (result bool, ok bool, version uint64) {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	result, ok = cache.entries[target]
+	return result, ok, cache.version
+}
+
+func (cache *supertypeCache) store(target Type, result bool, version uint64) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.version != version {
+		return
+	}
+	if cache.entries == nil {
+		cache.entries = map[Type]bool{}
+	}
+	cache.entries[target] = result
+}
+
+func (cache *supertypeCache) invalidate() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.entries = nil
+	cache.version++
+}
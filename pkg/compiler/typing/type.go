@@ -10,14 +10,14 @@ type Type interface {
 
 type Visitor interface {
 	VisitList(*ListType)
+	VisitMap(*MapType)
 	VisitGeneric(*GenericType)
 	VisitConcrete(*ConcreteType)
 	VisitOptional(*OptionalType)
+	VisitUnion(*UnionType)
+	VisitParameter(*ParameterType)
 }
 
 func NewEmptyClass(name string) Type {
-	return &ConcreteType{
-		Name:   name,
-		Traits: []Type{},
-	}
+	return &ConcreteType{Name: name}
 }
@@ -0,0 +1,43 @@
+package typing
+
+// UnionType is the type of a union, which is a closed set of cases. The type
+// of every case is a member of the union.
+type UnionType struct {
+	Name  string
+	Cases []Type
+}
+
+func (union *UnionType) Concrete() Type {
+	return union
+}
+
+func (union *UnionType) String() string {
+	return union.Name
+}
+
+// Is returns true if the target is the same union or a union, that has
+// every case of this union as its member.
+func (union *UnionType) Is(target Type) bool {
+	return IsSubtype(union, target)
+}
+
+// Contains returns true if the passed type is a member of the union.
+func (union *UnionType) Contains(member Type) bool {
+	for _, unionCase := range union.Cases {
+		if IsSubtype(member, unionCase) {
+			return true
+		}
+	}
+	return false
+}
+
+func (union *UnionType) Accept(visitor Visitor) {
+	visitor.VisitUnion(union)
+}
+
+func (union *UnionType) AcceptRecursive(visitor Visitor) {
+	union.Accept(visitor)
+	for _, unionCase := range union.Cases {
+		unionCase.AcceptRecursive(visitor)
+	}
+}